@@ -0,0 +1,10 @@
+# Invoke with: terraform apply -invoke=action.supabase_pause_project.staging
+action "supabase_pause_project" "staging" {
+  config {
+    project_ref = "mayuaycdtijbctgqbycg"
+
+    timeouts {
+      invoke = "10m"
+    }
+  }
+}
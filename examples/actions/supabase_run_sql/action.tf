@@ -0,0 +1,7 @@
+# Invoke with: terraform apply -invoke=action.supabase_run_sql.refresh_stats
+action "supabase_run_sql" "refresh_stats" {
+  config {
+    project_ref = "mayuaycdtijbctgqbycg"
+    query       = "refresh materialized view concurrently public.daily_stats"
+  }
+}
@@ -0,0 +1,13 @@
+data "supabase_backups" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+}
+
+check "recent_backup" {
+  assert {
+    condition = anytrue([
+      for backup in data.supabase_backups.production.backups :
+      backup.status == "COMPLETED" && timecmp(backup.inserted_at, timeadd(plantimestamp(), "-48h")) > 0
+    ])
+    error_message = "No completed backup in the last 48 hours."
+  }
+}
@@ -0,0 +1,3 @@
+data "supabase_project" "production" {
+  id = "mayuaycdtijbctgqbycg"
+}
@@ -0,0 +1,3 @@
+ephemeral "supabase_apikeys" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+}
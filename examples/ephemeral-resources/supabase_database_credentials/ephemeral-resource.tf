@@ -0,0 +1,4 @@
+ephemeral "supabase_database_credentials" "migrations" {
+  project_ref     = "mayuaycdtijbctgqbycg"
+  connection_type = "pooler"
+}
@@ -17,6 +17,22 @@ var (
 	ApiKeyResourceConfig string
 	//go:embed resources/supabase_third_party_auth/resource.tf
 	ThirdPartyAuthResourceConfig string
+	//go:embed resources/supabase_project_addon/resource.tf
+	ProjectAddonResourceConfig string
+	//go:embed resources/supabase_disk/resource.tf
+	DiskResourceConfig string
+	//go:embed resources/supabase_read_replica/resource.tf
+	ReadReplicaResourceConfig string
+	//go:embed resources/supabase_project_restore/resource.tf
+	ProjectRestoreResourceConfig string
+	//go:embed resources/supabase_vanity_subdomain/resource.tf
+	VanitySubdomainResourceConfig string
+	//go:embed resources/supabase_custom_hostname/resource.tf
+	CustomHostnameResourceConfig string
+	//go:embed resources/supabase_custom_hostname_activation/resource.tf
+	CustomHostnameActivationResourceConfig string
+	//go:embed resources/supabase_jwt_signing_key/resource.tf
+	JwtSigningKeyResourceConfig string
 	//go:embed data-sources/supabase_branch/data-source.tf
 	BranchDataSourceConfig string
 	//go:embed data-sources/supabase_pooler/data-source.tf
@@ -25,4 +41,36 @@ var (
 	APIKeysDataSourceConfig string
 	//go:embed data-sources/supabase_network_bans/data-source.tf
 	NetworkBansDataSourceConfig string
+	//go:embed data-sources/supabase_project/data-source.tf
+	ProjectDataSourceConfig string
+	//go:embed data-sources/supabase_projects/data-source.tf
+	ProjectsDataSourceConfig string
+	//go:embed data-sources/supabase_backups/data-source.tf
+	BackupsDataSourceConfig string
+	//go:embed ephemeral-resources/supabase_apikeys/ephemeral-resource.tf
+	APIKeysEphemeralResourceConfig string
+	//go:embed ephemeral-resources/supabase_database_credentials/ephemeral-resource.tf
+	DatabaseCredentialsEphemeralResourceConfig string
+	//go:embed functions/project_url/function.tf
+	ProjectUrlFunctionConfig string
+	//go:embed functions/connection_string/function.tf
+	ConnectionStringFunctionConfig string
+	//go:embed functions/parse_ref/function.tf
+	ParseRefFunctionConfig string
+	//go:embed functions/jwt_claims/function.tf
+	JwtClaimsFunctionConfig string
+	//go:embed actions/supabase_pause_project/action.tf
+	PauseProjectActionConfig string
+	//go:embed actions/supabase_run_sql/action.tf
+	RunSqlActionConfig string
+	//go:embed list-resources/supabase_project/list-resource.tfquery.hcl
+	ProjectListResourceConfig string
+	//go:embed list-resources/supabase_branch/list-resource.tfquery.hcl
+	BranchListResourceConfig string
+	//go:embed list-resources/supabase_edge_function/list-resource.tfquery.hcl
+	EdgeFunctionListResourceConfig string
+	//go:embed list-resources/supabase_apikey/list-resource.tfquery.hcl
+	ApiKeyListResourceConfig string
+	//go:embed list-resources/supabase_third_party_auth/list-resource.tfquery.hcl
+	ThirdPartyAuthListResourceConfig string
 )
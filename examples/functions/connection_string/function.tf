@@ -0,0 +1,9 @@
+variable "database_password" {
+  type      = string
+  sensitive = true
+}
+
+output "migrations_url" {
+  value     = provider::supabase::connection_string("mayuaycdtijbctgqbycg", var.database_password, "session", true, "aws-0-us-east-1.pooler.supabase.com")
+  sensitive = true
+}
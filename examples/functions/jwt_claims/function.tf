@@ -0,0 +1,13 @@
+data "supabase_apikeys" "production" {
+  project_ref = "mayuaycdtijbctgqbycg"
+}
+
+check "service_role_key" {
+  assert {
+    condition = (
+      provider::supabase::jwt_claims(data.supabase_apikeys.production.service_role_key).ref == "mayuaycdtijbctgqbycg" &&
+      provider::supabase::jwt_claims(data.supabase_apikeys.production.service_role_key).role == "service_role"
+    )
+    error_message = "The service role key does not belong to the production project."
+  }
+}
@@ -0,0 +1,3 @@
+output "project_ref" {
+  value = provider::supabase::parse_ref("https://mayuaycdtijbctgqbycg.supabase.co")
+}
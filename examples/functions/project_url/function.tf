@@ -0,0 +1,3 @@
+output "api_url" {
+  value = provider::supabase::project_url("mayuaycdtijbctgqbycg")
+}
@@ -0,0 +1,7 @@
+list "supabase_apikey" "all" {
+  provider = supabase
+
+  config {
+    project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
@@ -0,0 +1,7 @@
+list "supabase_branch" "all" {
+  provider = supabase
+
+  config {
+    parent_project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
@@ -0,0 +1,8 @@
+list "supabase_edge_function" "all" {
+  provider         = supabase
+  include_resource = true
+
+  config {
+    project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
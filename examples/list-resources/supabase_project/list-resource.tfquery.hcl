@@ -0,0 +1,7 @@
+list "supabase_project" "all" {
+  provider = supabase
+
+  config {
+    organization_id = "continued-brown-smelt"
+  }
+}
@@ -0,0 +1,7 @@
+list "supabase_third_party_auth" "all" {
+  provider = supabase
+
+  config {
+    project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
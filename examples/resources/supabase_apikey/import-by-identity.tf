@@ -0,0 +1,8 @@
+# The id may also be the name of the key, as long as it is unique in the project.
+import {
+  to = supabase_apikey.example
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+    id          = "d9bece6b-52cc-4d67-a948-2349d46676f5"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = supabase_branch.development
+  identity = {
+    id = "3574ed44-5151-4f01-a6e3-2bc0339152d9"
+  }
+}
@@ -0,0 +1,19 @@
+resource "supabase_custom_hostname" "api" {
+  project_ref     = "mayuaycdtijbctgqbycg"
+  custom_hostname = "api.example.com"
+}
+
+# Records to create with your DNS provider
+output "cname" {
+  value = {
+    name  = supabase_custom_hostname.api.custom_hostname
+    value = supabase_custom_hostname.api.cname_target
+  }
+}
+
+output "txt" {
+  value = concat(
+    [supabase_custom_hostname.api.ownership_verification],
+    supabase_custom_hostname.api.ssl_validation_records,
+  )
+}
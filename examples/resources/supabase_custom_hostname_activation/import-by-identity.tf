@@ -0,0 +1,6 @@
+import {
+  to = supabase_custom_hostname_activation.api
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
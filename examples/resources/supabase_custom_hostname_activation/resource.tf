@@ -0,0 +1,15 @@
+resource "supabase_custom_hostname" "api" {
+  project_ref     = "mayuaycdtijbctgqbycg"
+  custom_hostname = "api.example.com"
+}
+
+# Create the CNAME and TXT records exposed by supabase_custom_hostname.api with
+# your DNS provider, and add them to depends_on so activation waits for them.
+resource "supabase_custom_hostname_activation" "api" {
+  project_ref     = supabase_custom_hostname.api.project_ref
+  custom_hostname = supabase_custom_hostname.api.custom_hostname
+
+  timeouts {
+    create = "30m"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = supabase_disk.production
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
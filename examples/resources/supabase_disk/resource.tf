@@ -0,0 +1,7 @@
+resource "supabase_disk" "production" {
+  project_ref      = "mayuaycdtijbctgqbycg"
+  type             = "gp3"
+  size_gb          = 100
+  iops             = 3000
+  throughput_mibps = 250
+}
@@ -0,0 +1,7 @@
+import {
+  to = supabase_edge_function.example
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+    slug        = "hello-world"
+  }
+}
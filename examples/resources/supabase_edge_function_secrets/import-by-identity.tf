@@ -0,0 +1,6 @@
+import {
+  to = supabase_edge_function_secrets.example
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
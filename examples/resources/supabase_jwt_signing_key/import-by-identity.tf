@@ -0,0 +1,7 @@
+import {
+  to = supabase_jwt_signing_key.current
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+    id          = "99999999-9999-4999-8999-999999999999"
+  }
+}
@@ -0,0 +1,7 @@
+# JWT signing keys can be imported using the project reference and the key ID,
+# separated by a '/'.
+#
+# - project_ref: Found in the Supabase dashboard under Project Settings -> General,
+#   or in the project's URL: https://supabase.com/dashboard/project/<project_ref>
+# - key_id: The UUID of the signing key, found under Project Settings -> JWT Keys.
+terraform import supabase_jwt_signing_key.current <project_ref>/<key_id>
@@ -0,0 +1,16 @@
+# Key currently signing auth tokens
+resource "supabase_jwt_signing_key" "current" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  algorithm   = "ES256"
+  status      = "in_use"
+
+  # Promote the next key before demoting this one when rotating
+  depends_on = [supabase_jwt_signing_key.next]
+}
+
+# Standby key whose public_jwk verifiers can trust ahead of rotation. To rotate,
+# set its status to "in_use" and the current key's status to "previously_used".
+resource "supabase_jwt_signing_key" "next" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  algorithm   = "ES256"
+}
@@ -0,0 +1,6 @@
+import {
+  to = supabase_project.production
+  identity = {
+    id = "mayuaycdtijbctgqbycg"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = supabase_project_addon.pitr
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+    addon_type  = "pitr"
+  }
+}
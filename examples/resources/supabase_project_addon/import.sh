@@ -0,0 +1,7 @@
+# Project add-ons can be imported using the project reference and the add-on
+# type, separated by a '/'.
+#
+# - project_ref: Found in the Supabase dashboard under Project Settings -> General,
+#   or in the project's URL: https://supabase.com/dashboard/project/<project_ref>
+# - addon_type: One of the add-on types enabled on the project, e.g. ipv4 or pitr.
+terraform import supabase_project_addon.pitr <project_ref>/<addon_type>
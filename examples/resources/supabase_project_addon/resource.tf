@@ -0,0 +1,11 @@
+resource "supabase_project_addon" "ipv4" {
+  project_ref   = "mayuaycdtijbctgqbycg"
+  addon_type    = "ipv4"
+  addon_variant = "ipv4_default"
+}
+
+resource "supabase_project_addon" "pitr" {
+  project_ref   = "mayuaycdtijbctgqbycg"
+  addon_type    = "pitr"
+  addon_variant = "pitr_7"
+}
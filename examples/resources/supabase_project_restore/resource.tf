@@ -0,0 +1,9 @@
+# Restore to a point in time, requires the PITR add-on
+resource "supabase_project_restore" "drill" {
+  project_ref          = "mayuaycdtijbctgqbycg"
+  recovery_time_target = "2025-03-01T12:00:00Z"
+
+  timeouts {
+    create = "60m"
+  }
+}
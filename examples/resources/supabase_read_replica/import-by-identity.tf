@@ -0,0 +1,7 @@
+import {
+  to = supabase_read_replica.eu
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+    id          = "mayuaycdtijbctgqbycg-rr-eu-west-1-abcde"
+  }
+}
@@ -0,0 +1,7 @@
+# Read replicas can be imported using the project reference and the database
+# identifier of the replica, separated by a '/'.
+#
+# - project_ref: Found in the Supabase dashboard under Project Settings -> General,
+#   or in the project's URL: https://supabase.com/dashboard/project/<project_ref>
+# - database_identifier: The identifier of the replica, e.g. <project_ref>-rr-eu-west-1-abcde.
+terraform import supabase_read_replica.eu <project_ref>/<database_identifier>
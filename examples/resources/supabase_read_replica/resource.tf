@@ -0,0 +1,4 @@
+resource "supabase_read_replica" "eu" {
+  project_ref = "mayuaycdtijbctgqbycg"
+  region      = "eu-west-1"
+}
@@ -0,0 +1,6 @@
+import {
+  to = supabase_settings.production
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = supabase_third_party_auth.oidc
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+    id          = "88888888-8888-4888-8888-888888888888"
+  }
+}
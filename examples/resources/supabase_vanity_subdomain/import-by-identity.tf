@@ -0,0 +1,6 @@
+import {
+  to = supabase_vanity_subdomain.production
+  identity = {
+    project_ref = "mayuaycdtijbctgqbycg"
+  }
+}
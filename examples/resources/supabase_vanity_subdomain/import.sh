@@ -0,0 +1,5 @@
+# Vanity subdomains can be imported using the project reference.
+#
+# - project_ref: Found in the Supabase dashboard under Project Settings -> General,
+#   or in the project's URL: https://supabase.com/dashboard/project/<project_ref>
+terraform import supabase_vanity_subdomain.production <project_ref>
@@ -0,0 +1,4 @@
+resource "supabase_vanity_subdomain" "production" {
+  project_ref      = "mayuaycdtijbctgqbycg"
+  vanity_subdomain = "acme"
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &ApiKeyListResource{}
+	_ list.ListResourceWithConfigure = &ApiKeyListResource{}
+)
+
+func NewApiKeyListResource() list.ListResource {
+	return &ApiKeyListResource{}
+}
+
+// ApiKeyListResource defines the list resource implementation.
+type ApiKeyListResource struct {
+	client Client
+}
+
+// ApiKeyListResourceModel describes the list resource config model.
+type ApiKeyListResourceModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+}
+
+func (r *ApiKeyListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikey"
+}
+
+func (r *ApiKeyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the publishable and secret API keys of a project. Legacy keys cannot be managed and are not listed.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ApiKeyListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *ApiKeyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ApiKeyListResourceModel
+
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	httpResp, err := r.client.V1GetProjectApiKeysWithResponse(ctx, config.ProjectRef.ValueString(), &api.V1GetProjectApiKeysParams{})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list api keys, got error: %s", err)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list api keys, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+
+	keys := make([]api.ApiKeyResponse, 0, len(*httpResp.JSON200))
+	for _, key := range *httpResp.JSON200 {
+		if !key.Id.IsSpecified() || key.Id.IsNull() || !key.Type.IsSpecified() || key.Type.IsNull() {
+			continue
+		}
+		switch key.Type.MustGet() {
+		case api.ApiKeyResponseTypePublishable, api.ApiKeyResponseTypeSecret:
+			keys = append(keys, key)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, keys, func(key api.ApiKeyResponse, result *list.ListResult) {
+		data := ApiKeyResourceModel{
+			ProjectRef: config.ProjectRef,
+			Id:         types.StringValue(key.Id.MustGet()),
+		}
+		result.DisplayName = key.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, apiKeyIdentity(&data))...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		data.ProjectRef = config.ProjectRef
+		data.Id = types.StringValue(key.Id.MustGet())
+		if diags := readApiKey(ctx, &data, r.client); diags.HasError() {
+			result.Diagnostics.Append(diags...)
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/oapi-codegen/nullable"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccApiKeyListResource(t *testing.T) {
+	defer gock.OffAll()
+
+	gock.New(defaultApiEndpoint).
+		Get(apiKeysApiPath + "$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.ApiKeyResponse{
+			{
+				Name: "anon",
+				Type: nullable.NewNullableWithValue(api.ApiKeyResponseTypeLegacy),
+			},
+			{
+				Id:   nullable.NewNullableWithValue(testApiKeyUUID),
+				Name: "internal",
+				Type: nullable.NewNullableWithValue(api.ApiKeyResponseTypeSecret),
+			},
+		})
+	gock.New(defaultApiEndpoint).
+		Get(apiKeyApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testInternalApiKey("service_role"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Query: true,
+				Config: testAccQueryProviderConfig + examples.ApiKeyListResourceConfig + `
+list "supabase_apikey" "full" {
+  provider         = supabase
+  include_resource = true
+
+  config {
+    project_ref = "` + testProjectRef + `"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("supabase_apikey.all", 1),
+					querycheck.ExpectIdentity("supabase_apikey.all", map[string]knownvalue.Check{
+						"project_ref": knownvalue.StringExact(testProjectRef),
+						"id":          knownvalue.StringExact(testApiKeyUUID),
+					}),
+					querycheck.ExpectResourceKnownValues("supabase_apikey.full", queryfilter.ByDisplayName(knownvalue.StringExact("internal")), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("type"), KnownValue: knownvalue.StringExact("secret")},
+						{Path: tfjsonpath.New("secret_jwt_template").AtMapKey("role"), KnownValue: knownvalue.StringExact("service_role")},
+					}),
+				},
+			},
+		},
+	})
+}
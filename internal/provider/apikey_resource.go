@@ -5,10 +5,12 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -29,17 +32,43 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &APIKeyResource{}
-	_ resource.ResourceWithImportState = &APIKeyResource{}
+	_ resource.Resource                   = &APIKeyResource{}
+	_ resource.ResourceWithImportState    = &APIKeyResource{}
+	_ resource.ResourceWithValidateConfig = &APIKeyResource{}
+	_ resource.ResourceWithModifyPlan     = &APIKeyResource{}
+	_ resource.ResourceWithIdentity       = &APIKeyResource{}
 )
 
+// Role claim of secret keys created without an explicit secret_jwt_template.
+const defaultSecretJwtRole = "service_role"
+
+const (
+	// How long a rotated key stays valid when the rotation block sets no overlap.
+	defaultApiKeyRotationOverlap = 24 * time.Hour
+	// Private state key holding the deletion schedule of the previous key.
+	apiKeyRotationPrivateKey = "rotation"
+	// Appended to the name of the key replaced by a rotation, so it can be told apart from its replacement.
+	previousApiKeyNameSuffix = "_previous"
+)
+
+var apiKeyRotationAttrTypes = map[string]attr.Type{
+	"rotate_when": types.MapType{ElemType: types.StringType},
+	"overlap":     types.StringType,
+}
+
+// apiKeyRotationSchedule is persisted in private state after a rotation.
+type apiKeyRotationSchedule struct {
+	PreviousId string    `json:"previous_id"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
 func NewApiKeyResource() resource.Resource {
 	return &APIKeyResource{}
 }
 
 // APIKeysDataSource defines the data source implementation.
 type APIKeyResource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 var secretJwtTemplateAttrTypes = map[string]attr.Type{
@@ -71,15 +100,51 @@ type ApiKeyResourceModel struct {
 	ApiKey            types.String `tfsdk:"api_key"`
 	SecretJwtTemplate types.Object `tfsdk:"secret_jwt_template"`
 	Id                types.String `tfsdk:"id"`
+	Rotation          types.Object `tfsdk:"rotation"`
+	PreviousId        types.String `tfsdk:"previous_id"`
+	PreviousApiKey    types.String `tfsdk:"previous_api_key"`
+}
+
+type ApiKeyResourceIdentityModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func apiKeyIdentity(data *ApiKeyResourceModel) ApiKeyResourceIdentityModel {
+	return ApiKeyResourceIdentityModel{
+		ProjectRef: data.ProjectRef,
+		Id:         data.Id,
+	}
 }
 
 func (d *APIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_apikey"
+	// Rotation replaces the key, and with it the id, during an update
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (d *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "API Key resource",
+		Blocks: map[string]schema.Block{
+			"rotation": schema.SingleNestedBlock{
+				MarkdownDescription: "Rotates the key without downtime. When `rotate_when` changes, the current key is renamed with a `_previous` " +
+					"suffix and kept as `previous_api_key`, then a new key is created under `name`. The previous key is deleted on the first apply " +
+					"after `overlap` has passed. Changing `rotate_when` again before then is refused at plan time. Adding the block or `rotate_when` " +
+					"to an existing key does not rotate it.",
+				Attributes: map[string]schema.Attribute{
+					"rotate_when": schema.MapAttribute{
+						MarkdownDescription: "Arbitrary map of values that, when changed, rotates the key",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"overlap": schema.StringAttribute{
+						MarkdownDescription: "How long the previous key stays valid after a rotation, as a duration such as `72h`. Defaults to `24h`.",
+						Optional:            true,
+					},
+				},
+			},
+		},
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -108,10 +173,18 @@ func (d *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "Type of the API key",
+				MarkdownDescription: "Type of the API key, either `publishable` or `secret`. Defaults to `secret`.",
+				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.CreateApiKeyBodyTypePublishable),
+						string(api.CreateApiKeyBodyTypeSecret),
+					),
 				},
 			},
 			"api_key": schema.StringAttribute{
@@ -119,15 +192,32 @@ func (d *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"previous_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the key replaced by the last rotation, until it is deleted",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_api_key": schema.StringAttribute{
+				MarkdownDescription: "API key replaced by the last rotation, until it is deleted",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"secret_jwt_template": schema.SingleNestedAttribute{
-				MarkdownDescription: "Secret JWT template",
+				MarkdownDescription: "Secret JWT template. Only applies to secret keys, which default to the `service_role` role.",
+				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.Object{
 					objectplanmodifier.UseStateForUnknown(),
 				},
 				Attributes: map[string]schema.Attribute{
 					"role": schema.StringAttribute{
-						MarkdownDescription: "Role of the secret JWT template",
+						MarkdownDescription: "Postgres role that requests made with the key assume, defaults to `service_role`",
+						Optional:            true,
 						Computed:            true,
 					},
 				},
@@ -136,6 +226,97 @@ func (d *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (d *APIKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_ref": identityschema.StringAttribute{
+				Description:       "Project reference ID",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "API key ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (d *APIKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ApiKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.ValueString() == string(api.CreateApiKeyBodyTypePublishable) && !data.SecretJwtTemplate.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("secret_jwt_template"), "Invalid Attribute Combination",
+			"secret_jwt_template can only be set on secret API keys")
+	}
+
+	if _, err := apiKeyRotationOverlap(data.Rotation); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rotation").AtName("overlap"), "Invalid Rotation Overlap", err.Error())
+	}
+}
+
+func (d *APIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	// A new key has not replaced anything yet
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_id"), types.StringNull())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_api_key"), types.StringNull())...)
+		return
+	}
+
+	var plan, state ApiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, diags := getApiKeyRotationSchedule(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a change of an existing trigger rotates, adding or removing it leaves the key alone
+	priorRotateWhen, plannedRotateWhen := apiKeyRotateWhen(state.Rotation), apiKeyRotateWhen(plan.Rotation)
+	if !priorRotateWhen.IsNull() && !plannedRotateWhen.IsNull() && !plannedRotateWhen.Equal(priorRotateWhen) {
+		// Only one previous key is kept, so it must not be cut short by another rotation
+		if !state.PreviousId.IsNull() && schedule != nil && time.Now().Before(schedule.ExpiresAt) {
+			resp.Diagnostics.AddAttributeError(path.Root("rotation").AtName("rotate_when"), "Rotation Overlap Not Passed",
+				fmt.Sprintf("The previous API key %s stays valid until %s. Rotate again once the overlap has passed.",
+					schedule.PreviousId, schedule.ExpiresAt.Format(time.RFC3339)))
+			return
+		}
+		// The current key becomes the previous key once its replacement exists
+		plan.Id = types.StringUnknown()
+		plan.ApiKey = types.StringUnknown()
+		plan.PreviousId = state.Id
+		plan.PreviousApiKey = state.ApiKey
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	if schedule == nil {
+		return
+	}
+	if time.Now().Before(schedule.ExpiresAt) {
+		tflog.Debug(ctx, "Previous API key still within rotation overlap", map[string]any{
+			"previous_id": schedule.PreviousId,
+			"expires_at":  schedule.ExpiresAt,
+		})
+		return
+	}
+
+	// Plan the removal of the previous key, it is deleted during apply
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_id"), types.StringNull())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_api_key"), types.StringNull())...)
+}
+
 func (d *APIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
 		d.client = client
@@ -162,6 +343,7 @@ func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, apiKeyIdentity(&data))...)
 }
 
 func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -178,39 +360,90 @@ func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	if !data.PreviousId.IsNull() {
+		found, diags := readPreviousApiKey(ctx, data.ProjectRef.ValueString(), data.PreviousId.ValueString(), r.client)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !found {
+			// The previous key was deleted outside of Terraform, there is nothing left to clean up
+			data.PreviousId = types.StringNull()
+			data.PreviousApiKey = types.StringNull()
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyRotationPrivateKey, nil)...)
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, apiKeyIdentity(&data))...)
 }
 
 func (r *APIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ApiKeyResourceModel
+	var data, state ApiKeyResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(updateApiKey(ctx, &data, r.client)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if data.Id.IsUnknown() {
+		resp.Diagnostics.Append(rotateApiKey(ctx, &data, &state, resp.Private, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Trace(ctx, "rotated api key")
+	} else {
+		if data.PreviousId.IsNull() && !state.PreviousId.IsNull() {
+			resp.Diagnostics.Append(deletePreviousApiKey(ctx, data.ProjectRef.ValueString(), state.PreviousId.ValueString(), r.client)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyRotationPrivateKey, nil)...)
+			tflog.Trace(ctx, "deleted previous api key")
+		}
+
+		if apiKeyFieldsChanged(&data, &state) {
+			resp.Diagnostics.Append(updateApiKey(ctx, &data, r.client)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		} else {
+			// Only the rotation settings or the previous key changed
+			data.ApiKey = state.ApiKey
+		}
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, apiKeyIdentity(&data))...)
 }
 
 func (r *APIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	projectRef, apiKeyID, diag := resolveAPIKeyImportID(ctx, r.client, req.ID)
+	importID := req.ID
+	if importID == "" {
+		var identity ApiKeyResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.ProjectRef.ValueString() + "/" + identity.Id.ValueString()
+	}
+
+	projectRef, apiKeyID, diag := resolveAPIKeyImportID(ctx, r.client, importID)
 	if diag != nil {
 		resp.Diagnostics.Append(diag)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_ref"), types.StringValue(projectRef))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(apiKeyID))...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("project_ref"), types.StringValue(projectRef))...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), types.StringValue(apiKeyID))...)
 }
 
-func resolveAPIKeyImportID(ctx context.Context, client *api.ClientWithResponses, importID string) (projectRef, apiKeyID string, _ diag.Diagnostic) {
+func resolveAPIKeyImportID(ctx context.Context, client Client, importID string) (projectRef, apiKeyID string, _ diag.Diagnostic) {
 	parts := strings.Split(importID, "/")
 	switch len(parts) {
 	case 3:
@@ -238,7 +471,7 @@ Example: myprojectref/00000000-0000-0000-0000-000000000000`,
 	}
 }
 
-func importAPIKeyByNameOrID(ctx context.Context, client *api.ClientWithResponses, projectRef, keyRef string) (string, diag.Diagnostic) {
+func importAPIKeyByNameOrID(ctx context.Context, client Client, projectRef, keyRef string) (string, diag.Diagnostic) {
 	if projectRef == "" || keyRef == "" {
 		return "", diag.NewErrorDiagnostic(
 			"Unexpected Import Identifier",
@@ -295,7 +528,7 @@ Please use a more specific identifier like myprojectref/mykeyname/type or myproj
 	return foundKeyID, nil
 }
 
-func importAPIKeyByNameAndType(ctx context.Context, client *api.ClientWithResponses, projectRef, keyName, keyType string) (string, diag.Diagnostic) {
+func importAPIKeyByNameAndType(ctx context.Context, client Client, projectRef, keyName, keyType string) (string, diag.Diagnostic) {
 	if projectRef == "" || keyName == "" || keyType == "" {
 		return "", diag.NewErrorDiagnostic(
 			"Unexpected Import Identifier",
@@ -357,14 +590,21 @@ func (r *APIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if !data.PreviousId.IsNull() {
+		resp.Diagnostics.Append(deletePreviousApiKey(ctx, data.ProjectRef.ValueString(), data.PreviousId.ValueString(), r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(deleteApiKey(ctx, &data, r.client)...)
 }
 
-func readApiKey(ctx context.Context, state *ApiKeyResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readApiKey(ctx context.Context, state *ApiKeyResourceModel, client Client) diag.Diagnostics {
 	return readApiKeyDatabase(ctx, state, client)
 }
 
-func readApiKeyDatabase(ctx context.Context, state *ApiKeyResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readApiKeyDatabase(ctx context.Context, state *ApiKeyResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1GetProjectApiKeyWithResponse(ctx, state.ProjectRef.ValueString(), uuid.MustParse(state.Id.ValueString()), &api.V1GetProjectApiKeyParams{Reveal: Ptr(true)})
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read apiKey database, got error: %s", err)
@@ -422,7 +662,7 @@ func readApiKeyDatabase(ctx context.Context, state *ApiKeyResourceModel, client
 	return nil
 }
 
-func createApiKey(ctx context.Context, plan *ApiKeyResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func createApiKey(ctx context.Context, plan *ApiKeyResourceModel, client Client) diag.Diagnostics {
 	reveal := Ptr(true)
 	resp, err := client.V1GetProjectApiKeysWithResponse(ctx, plan.ProjectRef.ValueString(), &api.V1GetProjectApiKeysParams{Reveal: reveal})
 	if err != nil {
@@ -464,11 +704,24 @@ func createApiKey(ctx context.Context, plan *ApiKeyResourceModel, client *api.Cl
 	}
 
 	// 2. Create apiKey
-	httpResp, err := client.V1CreateProjectApiKeyWithResponse(ctx, plan.ProjectRef.ValueString(), &api.V1CreateProjectApiKeyParams{Reveal: reveal}, api.CreateApiKeyBody{
+	return createProjectApiKey(ctx, plan, client)
+}
+
+func createProjectApiKey(ctx context.Context, plan *ApiKeyResourceModel, client Client) diag.Diagnostics {
+	keyType := api.CreateApiKeyBodyTypeSecret
+	if !plan.Type.IsNull() && !plan.Type.IsUnknown() {
+		keyType = api.CreateApiKeyBodyType(plan.Type.ValueString())
+	}
+	var secretJwtTemplate nullable.Nullable[map[string]interface{}]
+	if keyType == api.CreateApiKeyBodyTypeSecret {
+		secretJwtTemplate = nullable.NewNullableWithValue(map[string]interface{}{"role": secretJwtRole(plan)})
+	}
+
+	httpResp, err := client.V1CreateProjectApiKeyWithResponse(ctx, plan.ProjectRef.ValueString(), &api.V1CreateProjectApiKeyParams{Reveal: Ptr(true)}, api.CreateApiKeyBody{
 		Name:              plan.Name.ValueString(),
-		Type:              api.CreateApiKeyBodyTypeSecret,
+		Type:              keyType,
 		Description:       nullable.Nullable[string]{},
-		SecretJwtTemplate: nullable.NewNullableWithValue(map[string]interface{}{"role": "service_role"}),
+		SecretJwtTemplate: secretJwtTemplate,
 	})
 	if err != nil {
 		msg := fmt.Sprintf("Unable to create apiKey, got error: %s", err)
@@ -484,21 +737,13 @@ func createApiKey(ctx context.Context, plan *ApiKeyResourceModel, client *api.Cl
 	plan.ApiKey = NullableToString(httpResp.JSON201.ApiKey)
 	plan.Type = NullableToString(httpResp.JSON201.Type)
 
-	obj, diags := types.ObjectValue(secretJwtTemplateAttrTypes, map[string]attr.Value{
-		"role": types.StringValue("service_role"),
-	})
-	if diags.HasError() {
-		return diags
-	}
-	plan.SecretJwtTemplate = obj
-
 	return readApiKeyDatabase(ctx, plan, client)
 }
 
-func updateApiKey(ctx context.Context, plan *ApiKeyResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateApiKey(ctx context.Context, plan *ApiKeyResourceModel, client Client) diag.Diagnostics {
 	var secretJwtTemplate nullable.Nullable[map[string]interface{}]
 	if plan.Type.ValueString() == string(api.ApiKeyResponseTypeSecret) {
-		secretJwtTemplate = nullable.NewNullableWithValue(map[string]interface{}{"role": "service_role"})
+		secretJwtTemplate = nullable.NewNullableWithValue(map[string]interface{}{"role": secretJwtRole(plan)})
 	} else {
 		secretJwtTemplate = nullable.Nullable[map[string]interface{}]{}
 	}
@@ -529,7 +774,180 @@ func updateApiKey(ctx context.Context, plan *ApiKeyResourceModel, client *api.Cl
 	return readApiKeyDatabase(ctx, plan, client)
 }
 
-func deleteApiKey(ctx context.Context, state *ApiKeyResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+// apiKeyFieldsChanged reports whether any attribute sent by updateApiKey differs from the prior state.
+func apiKeyFieldsChanged(plan, state *ApiKeyResourceModel) bool {
+	return !plan.Name.Equal(state.Name) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.SecretJwtTemplate.Equal(state.SecretJwtTemplate)
+}
+
+// renameApiKey only changes the name of a key, leaving its other attributes as they are.
+func renameApiKey(ctx context.Context, projectRef, id, name string, client Client) diag.Diagnostics {
+	keyId, err := uuid.Parse(id)
+	if err != nil {
+		msg := fmt.Sprintf("Invalid API key ID %q: %s", id, err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	httpResp, err := client.V1UpdateProjectApiKeyWithResponse(ctx, projectRef, keyId, &api.V1UpdateProjectApiKeyParams{}, api.UpdateApiKeyBody{
+		Name: &name,
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to rename apiKey, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to rename apiKey, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return nil
+}
+
+// rotateApiKey creates the replacement key first, keeping the current key as the previous key until the overlap passes.
+func rotateApiKey(ctx context.Context, plan, state *ApiKeyResourceModel, private apiKeyPrivateState, client Client) diag.Diagnostics {
+	projectRef := plan.ProjectRef.ValueString()
+
+	overlap, err := apiKeyRotationOverlap(plan.Rotation)
+	if err != nil {
+		return diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("rotation").AtName("overlap"), "Invalid Rotation Overlap", err.Error())}
+	}
+
+	// Only one previous key is kept, plan time checks ensure the overlap of an earlier one has passed
+	if !state.PreviousId.IsNull() {
+		if diags := deletePreviousApiKey(ctx, projectRef, state.PreviousId.ValueString(), client); diags.HasError() {
+			return diags
+		}
+	}
+
+	// Free the name for the replacement, which keeps the configured name
+	if diags := renameApiKey(ctx, projectRef, state.Id.ValueString(), state.Name.ValueString()+previousApiKeyNameSuffix, client); diags.HasError() {
+		return diags
+	}
+
+	if diags := createProjectApiKey(ctx, plan, client); diags.HasError() {
+		return diags
+	}
+	plan.PreviousId = state.Id
+	plan.PreviousApiKey = state.ApiKey
+
+	schedule, err := json.Marshal(apiKeyRotationSchedule{
+		PreviousId: state.Id.ValueString(),
+		ExpiresAt:  time.Now().Add(overlap).UTC(),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to save rotation schedule, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return private.SetKey(ctx, apiKeyRotationPrivateKey, schedule)
+}
+
+// apiKeyPrivateState is implemented by the private state of framework requests and responses.
+type apiKeyPrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func getApiKeyRotationSchedule(ctx context.Context, private apiKeyPrivateState) (*apiKeyRotationSchedule, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, apiKeyRotationPrivateKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	var schedule apiKeyRotationSchedule
+	if err := json.Unmarshal(value, &schedule); err != nil {
+		msg := fmt.Sprintf("Unable to parse rotation schedule, got error: %s", err)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return &schedule, nil
+}
+
+func apiKeyRotateWhen(rotation types.Object) types.Map {
+	if rotation.IsNull() || rotation.IsUnknown() {
+		return types.MapNull(types.StringType)
+	}
+	if rotateWhen, ok := rotation.Attributes()["rotate_when"].(types.Map); ok {
+		return rotateWhen
+	}
+	return types.MapNull(types.StringType)
+}
+
+func apiKeyRotationOverlap(rotation types.Object) (time.Duration, error) {
+	if rotation.IsNull() || rotation.IsUnknown() {
+		return defaultApiKeyRotationOverlap, nil
+	}
+	overlap, ok := rotation.Attributes()["overlap"].(types.String)
+	if !ok || overlap.IsNull() || overlap.IsUnknown() {
+		return defaultApiKeyRotationOverlap, nil
+	}
+	duration, err := time.ParseDuration(overlap.ValueString())
+	if err != nil {
+		return 0, err
+	}
+	if duration < 0 {
+		return 0, fmt.Errorf("overlap must not be negative, got: %s", overlap.ValueString())
+	}
+	return duration, nil
+}
+
+// readPreviousApiKey reports whether the key replaced by the last rotation still exists.
+func readPreviousApiKey(ctx context.Context, projectRef, id string, client Client) (bool, diag.Diagnostics) {
+	keyId, err := uuid.Parse(id)
+	if err != nil {
+		msg := fmt.Sprintf("Invalid previous API key ID %q: %s", id, err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	httpResp, err := client.V1GetProjectApiKeyWithResponse(ctx, projectRef, keyId, &api.V1GetProjectApiKeyParams{})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read previous apiKey, got error: %s", err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("previous api key not found: %s", id))
+		return false, nil
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read previous apiKey, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return true, nil
+}
+
+func deletePreviousApiKey(ctx context.Context, projectRef, id string, client Client) diag.Diagnostics {
+	keyId, err := uuid.Parse(id)
+	if err != nil {
+		msg := fmt.Sprintf("Invalid previous API key ID %q: %s", id, err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	httpResp, err := client.V1DeleteProjectApiKeyWithResponse(ctx, projectRef, keyId, &api.V1DeleteProjectApiKeyParams{})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to delete previous apiKey, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("previous api key not found: %s", id))
+		return nil
+	}
+	if httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to delete previous apiKey, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return nil
+}
+
+// secretJwtRole returns the configured role claim of a secret key, falling back to the default role.
+func secretJwtRole(plan *ApiKeyResourceModel) string {
+	if plan.SecretJwtTemplate.IsNull() || plan.SecretJwtTemplate.IsUnknown() {
+		return defaultSecretJwtRole
+	}
+	if role, ok := plan.SecretJwtTemplate.Attributes()["role"].(types.String); ok && !role.IsNull() && !role.IsUnknown() {
+		return role.ValueString()
+	}
+	return defaultSecretJwtRole
+}
+
+func deleteApiKey(ctx context.Context, state *ApiKeyResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1DeleteProjectApiKeyWithResponse(ctx, state.ProjectRef.ValueString(), uuid.MustParse(state.Id.ValueString()), &api.V1DeleteProjectApiKeyParams{Reveal: Ptr(true)})
 	if err != nil {
 		msg := fmt.Sprintf("Unable to delete apiKey, got error: %s", err)
@@ -4,13 +4,22 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/oapi-codegen/nullable"
 	"github.com/supabase/cli/pkg/api"
 	"github.com/supabase/terraform-provider-supabase/examples"
@@ -118,6 +127,399 @@ func TestAccApiKeyResource(t *testing.T) {
 	})
 }
 
+func apikeyResourceConfigWithRole(role string) string {
+	return fmt.Sprintf(`
+resource "supabase_apikey" "new" {
+  project_ref = %q
+  name        = "internal"
+  type        = "secret"
+  secret_jwt_template = {
+    role = %q
+  }
+}
+`, testProjectRef, role)
+}
+
+// matchSecretJwtRole matches requests whose JSON body sets the role claim of the secret JWT template.
+func matchSecretJwtRole(role string) gock.MatchFunc {
+	return func(req *http.Request, _ *gock.Request) (bool, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return false, err
+		}
+		req.Body = io.NopCloser(bytes.NewBuffer(body))
+		var payload api.CreateApiKeyBody
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return false, err
+		}
+		template, err := payload.SecretJwtTemplate.Get()
+		return err == nil && template["role"] == role, nil
+	}
+}
+
+func testInternalApiKey(role string) api.ApiKeyResponse {
+	return api.ApiKeyResponse{
+		Id:     nullable.NewNullableWithValue(testApiKeyUUID),
+		Name:   "internal",
+		Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypeSecret),
+		ApiKey: nullable.NewNullableWithValue("sb_secret_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
+		SecretJwtTemplate: nullable.NewNullableWithValue(map[string]interface{}{
+			"role": role,
+		}),
+	}
+}
+
+func TestAccApiKeyResource_CustomRole(t *testing.T) {
+	defer gock.OffAll()
+	// Step 1: create
+	gock.New(defaultApiEndpoint).
+		Get(apiKeysApiPath).
+		Reply(http.StatusOK).
+		JSON([]api.ApiKeyResponse{
+			{
+				Id:   nullable.NewNullableWithValue(uuid.New().String()),
+				Name: "default",
+				Type: nullable.NewNullableWithValue(api.ApiKeyResponseTypePublishable),
+			},
+		})
+	gock.New(defaultApiEndpoint).
+		Post(apiKeysApiPath).
+		AddMatcher(matchJSONBodyField("type", "secret")).
+		AddMatcher(matchSecretJwtRole("app_service")).
+		Reply(http.StatusCreated).
+		JSON(testInternalApiKey("app_service"))
+	gock.New(defaultApiEndpoint).
+		Get(apiKeyApiPath).
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(testInternalApiKey("app_service"))
+	// Step 2: update
+	gock.New(defaultApiEndpoint).
+		Patch(apiKeyApiPath).
+		AddMatcher(matchSecretJwtRole("reporting")).
+		Reply(http.StatusOK).
+		JSON(testInternalApiKey("reporting"))
+	gock.New(defaultApiEndpoint).
+		Get(apiKeyApiPath).
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(testInternalApiKey("reporting"))
+	gock.New(defaultApiEndpoint).
+		Delete(apiKeyApiPath).
+		Reply(http.StatusOK)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: apikeyResourceConfigWithRole("app_service"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_apikey.new", "type", "secret"),
+					resource.TestCheckResourceAttr("supabase_apikey.new", "secret_jwt_template.role", "app_service"),
+				),
+			},
+			{
+				Config: apikeyResourceConfigWithRole("reporting"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_apikey.new", "secret_jwt_template.role", "reporting"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApiKeyResource_ImportIdentity(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(apiKeysApiPath).
+		Reply(http.StatusOK).
+		JSON([]api.ApiKeyResponse{
+			{
+				Id:   nullable.NewNullableWithValue(uuid.New().String()),
+				Name: "default",
+				Type: nullable.NewNullableWithValue(api.ApiKeyResponseTypePublishable),
+			},
+		})
+	gock.New(defaultApiEndpoint).
+		Post(apiKeysApiPath).
+		Reply(http.StatusCreated).
+		JSON(testInternalApiKey("app_service"))
+	gock.New(defaultApiEndpoint).
+		Get(apiKeyApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testInternalApiKey("app_service"))
+	gock.New(defaultApiEndpoint).
+		Delete(apiKeyApiPath).
+		Reply(http.StatusOK)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: apikeyResourceConfigWithRole("app_service"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("supabase_apikey.new", map[string]knownvalue.Check{
+						"project_ref": knownvalue.StringExact(testProjectRef),
+						"id":          knownvalue.StringExact(testApiKeyUUID),
+					}),
+				},
+			},
+			{
+				ResourceName:    "supabase_apikey.new",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func apikeyResourceConfigWithRotation(version string) string {
+	return fmt.Sprintf(`
+resource "supabase_apikey" "new" {
+  project_ref = %q
+  name        = "internal"
+
+  rotation {
+    rotate_when = {
+      version = %q
+    }
+    overlap = "3s"
+  }
+}
+`, testProjectRef, version)
+}
+
+func TestAccApiKeyResource_Rotation(t *testing.T) {
+	const rotatedApiKeyUUID = "4f0a3c55-2b7e-4d16-8c3a-6e9b1f2d7a40"
+	rotatedApiKeyApiPath := apiKeysApiPath + "/" + rotatedApiKeyUUID
+	rotatedApiKey := testInternalApiKey(defaultSecretJwtRole)
+	rotatedApiKey.Id = nullable.NewNullableWithValue(rotatedApiKeyUUID)
+	rotatedApiKey.ApiKey = nullable.NewNullableWithValue("sb_secret_rotated")
+
+	defer gock.OffAll()
+	// Step 1: create
+	gock.New(defaultApiEndpoint).
+		Get(apiKeysApiPath).
+		Reply(http.StatusOK).
+		JSON([]api.ApiKeyResponse{
+			{
+				Id:   nullable.NewNullableWithValue(uuid.New().String()),
+				Name: "default",
+				Type: nullable.NewNullableWithValue(api.ApiKeyResponseTypePublishable),
+			},
+		})
+	gock.New(defaultApiEndpoint).
+		Post(apiKeysApiPath).
+		Reply(http.StatusCreated).
+		JSON(testInternalApiKey(defaultSecretJwtRole))
+	// Read keeps checking the previous key until it is deleted
+	gock.New(defaultApiEndpoint).
+		Get(apiKeyApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testInternalApiKey(defaultSecretJwtRole))
+	// Step 2: rotate, the current key is renamed and the new key is created before anything is deleted
+	gock.New(defaultApiEndpoint).
+		Patch(apiKeyApiPath).
+		AddMatcher(matchJSONBodyField("name", "internal_previous")).
+		Reply(http.StatusOK).
+		JSON(testInternalApiKey(defaultSecretJwtRole))
+	gock.New(defaultApiEndpoint).
+		Post(apiKeysApiPath).
+		AddMatcher(matchJSONBodyField("name", "internal")).
+		Reply(http.StatusCreated).
+		JSON(rotatedApiKey)
+	gock.New(defaultApiEndpoint).
+		Get(rotatedApiKeyApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(rotatedApiKey)
+	// Step 3: the previous key is deleted once the overlap has passed, nothing else is updated
+	gock.New(defaultApiEndpoint).
+		Delete(apiKeyApiPath).
+		Reply(http.StatusOK)
+	gock.New(defaultApiEndpoint).
+		Delete(rotatedApiKeyApiPath).
+		Reply(http.StatusOK)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: apikeyResourceConfigWithRotation("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_apikey.new", "id", testApiKeyUUID),
+					resource.TestCheckNoResourceAttr("supabase_apikey.new", "previous_id"),
+				),
+			},
+			{
+				Config: apikeyResourceConfigWithRotation("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_apikey.new", "id", rotatedApiKeyUUID),
+					resource.TestCheckResourceAttr("supabase_apikey.new", "api_key", "sb_secret_rotated"),
+					resource.TestCheckResourceAttr("supabase_apikey.new", "previous_id", testApiKeyUUID),
+					resource.TestCheckResourceAttr("supabase_apikey.new", "previous_api_key", "sb_secret_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
+				),
+			},
+			{
+				Config:      apikeyResourceConfigWithRotation("3"),
+				ExpectError: regexp.MustCompile(`Rotation Overlap Not Passed`),
+			},
+			{
+				PreConfig: func() { time.Sleep(3 * time.Second) },
+				Config:    apikeyResourceConfigWithRotation("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_apikey.new", "id", rotatedApiKeyUUID),
+					resource.TestCheckNoResourceAttr("supabase_apikey.new", "previous_id"),
+					resource.TestCheckNoResourceAttr("supabase_apikey.new", "previous_api_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApiKeyResource_RotationAdded(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(apiKeysApiPath).
+		Reply(http.StatusOK).
+		JSON([]api.ApiKeyResponse{
+			{
+				Id:   nullable.NewNullableWithValue(uuid.New().String()),
+				Name: "default",
+				Type: nullable.NewNullableWithValue(api.ApiKeyResponseTypePublishable),
+			},
+		})
+	gock.New(defaultApiEndpoint).
+		Post(apiKeysApiPath).
+		Reply(http.StatusCreated).
+		JSON(testInternalApiKey(defaultSecretJwtRole))
+	gock.New(defaultApiEndpoint).
+		Get(apiKeyApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testInternalApiKey(defaultSecretJwtRole))
+	// Adding the rotation block neither creates nor updates a key
+	gock.New(defaultApiEndpoint).
+		Delete(apiKeyApiPath).
+		Reply(http.StatusOK)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_apikey" "new" {
+  project_ref = %q
+  name        = "internal"
+}
+`, testProjectRef),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_apikey.new", "id", testApiKeyUUID),
+				),
+			},
+			{
+				Config: apikeyResourceConfigWithRotation("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_apikey.new", "id", testApiKeyUUID),
+					resource.TestCheckResourceAttr("supabase_apikey.new", "api_key", "sb_secret_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
+					resource.TestCheckNoResourceAttr("supabase_apikey.new", "previous_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestApiKeyFieldsChanged(t *testing.T) {
+	template := func(role string) types.Object {
+		return types.ObjectValueMust(secretJwtTemplateAttrTypes, map[string]attr.Value{"role": types.StringValue(role)})
+	}
+	state := ApiKeyResourceModel{
+		Name:              types.StringValue("internal"),
+		Description:       types.StringNull(),
+		SecretJwtTemplate: template(defaultSecretJwtRole),
+		PreviousId:        types.StringValue(testApiKeyUUID),
+	}
+
+	for name, tc := range map[string]struct {
+		update   func(plan *ApiKeyResourceModel)
+		expected bool
+	}{
+		"previous key removed": {update: func(plan *ApiKeyResourceModel) { plan.PreviousId = types.StringNull() }},
+		"name":                 {update: func(plan *ApiKeyResourceModel) { plan.Name = types.StringValue("renamed") }, expected: true},
+		"description":          {update: func(plan *ApiKeyResourceModel) { plan.Description = types.StringValue("backend") }, expected: true},
+		"role":                 {update: func(plan *ApiKeyResourceModel) { plan.SecretJwtTemplate = template("custom") }, expected: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			plan := state
+			tc.update(&plan)
+			if changed := apiKeyFieldsChanged(&plan, &state); changed != tc.expected {
+				t.Errorf("Expected changed=%v, got %v", tc.expected, changed)
+			}
+		})
+	}
+}
+
+func TestReadPreviousApiKey(t *testing.T) {
+	defer gock.OffAll()
+	gock.InterceptClient(http.DefaultClient)
+	defer gock.RestoreClient(http.DefaultClient)
+
+	gock.New(defaultApiEndpoint).
+		Get(apiKeyApiPath).
+		Reply(http.StatusOK).
+		JSON(testInternalApiKey(defaultSecretJwtRole))
+	// Deleted outside of Terraform
+	gock.New(defaultApiEndpoint).
+		Get(apiKeyApiPath).
+		Reply(http.StatusNotFound).
+		JSON(map[string]string{"message": "API key not found"})
+
+	client, err := newClient(defaultApiEndpoint)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	found, diags := readPreviousApiKey(t.Context(), testProjectRef, testApiKeyUUID, client)
+	if diags.HasError() || !found {
+		t.Errorf("Expected previous key to be found, got found=%v diags=%v", found, diags)
+	}
+	found, diags = readPreviousApiKey(t.Context(), testProjectRef, testApiKeyUUID, client)
+	if diags.HasError() || found {
+		t.Errorf("Expected deleted previous key to be dropped, got found=%v diags=%v", found, diags)
+	}
+}
+
+func TestAccApiKeyResource_PublishableWithTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_apikey" "new" {
+  project_ref = %q
+  name        = "web"
+  type        = "publishable"
+  secret_jwt_template = {
+    role = "anon"
+  }
+}
+`, testProjectRef),
+				ExpectError: regexp.MustCompile(`secret_jwt_template can only be set on secret API keys`),
+			},
+		},
+	})
+}
+
 func TestAccApiKeyResource_InvalidName(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -218,7 +620,7 @@ func TestResolveAPIKeyImportID(t *testing.T) {
 				tt.mock()
 			}
 
-			client, err := api.NewClientWithResponses(defaultApiEndpoint)
+			client, err := newClient(defaultApiEndpoint)
 			if err != nil {
 				t.Fatalf("Failed to create client: %v", err)
 			}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/supabase/cli/pkg/api"
@@ -24,7 +25,7 @@ func NewAPIKeysDataSource() datasource.DataSource {
 
 // APIKeysDataSource defines the data source implementation.
 type APIKeysDataSource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 // APIKeysDataSourceModel describes the data source data model.
@@ -101,15 +102,30 @@ func (d *APIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	httpResp, err := d.client.V1GetProjectApiKeysWithResponse(ctx, data.ProjectRef.ValueString(), &api.V1GetProjectApiKeysParams{Reveal: Ptr(true)})
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API keys, got error: %s", err))
+	resp.Diagnostics.Append(readProjectApiKeys(ctx, d.client, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	tflog.Trace(ctx, "read API keys")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Reads revealed API keys into the shared data source and ephemeral resource model.
+func readProjectApiKeys(ctx context.Context, client Client, data *APIKeysDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := client.V1GetProjectApiKeysWithResponse(ctx, data.ProjectRef.ValueString(), &api.V1GetProjectApiKeysParams{Reveal: Ptr(true)})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read API keys, got error: %s", err))
+		return diags
+	}
+
 	if httpResp.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API keys, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
-		return
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read API keys, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return diags
 	}
 
 	objectType := types.ObjectType{
@@ -135,13 +151,13 @@ func (d *APIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			case api.ApiKeyResponseTypePublishable:
 				data.PublishableKey = NullableToString(key.ApiKey)
 			case api.ApiKeyResponseTypeSecret:
-				obj, diags := types.ObjectValue(objectType.AttrTypes, map[string]attr.Value{
+				obj, objDiags := types.ObjectValue(objectType.AttrTypes, map[string]attr.Value{
 					"name":    types.StringValue(key.Name),
 					"api_key": NullableToString(key.ApiKey),
 				})
+				diags.Append(objDiags...)
 				if diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-					return
+					return diags
 				}
 				secretKeyObjects = append(secretKeyObjects, obj)
 			}
@@ -149,15 +165,8 @@ func (d *APIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Build list directly from object values
-	secretKeysList, diags := types.ListValue(objectType, secretKeyObjects)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
-	}
+	secretKeysList, listDiags := types.ListValue(objectType, secretKeyObjects)
+	diags.Append(listDiags...)
 	data.SecretKeys = secretKeysList
-
-	tflog.Trace(ctx, "read API keys")
-
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return diags
 }
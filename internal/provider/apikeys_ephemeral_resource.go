@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &APIKeysEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &APIKeysEphemeralResource{}
+)
+
+func NewAPIKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &APIKeysEphemeralResource{}
+}
+
+// APIKeysEphemeralResource defines the ephemeral resource implementation.
+type APIKeysEphemeralResource struct {
+	client Client
+}
+
+func (e *APIKeysEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikeys"
+}
+
+func (e *APIKeysEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "API Keys ephemeral resource. Keys are only available during the Terraform run and are never persisted to plan or state.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+			},
+			"anon_key": schema.StringAttribute{
+				MarkdownDescription: "Anonymous API key for the project",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"service_role_key": schema.StringAttribute{
+				MarkdownDescription: "Service role API key for the project",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"publishable_key": schema.StringAttribute{
+				MarkdownDescription: "Publishable API key for the project",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"secret_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "List of secret API keys for the project",
+				Computed:            true,
+				Sensitive:           true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the secret key",
+							Computed:            true,
+						},
+						"api_key": schema.StringAttribute{
+							MarkdownDescription: "The secret API key value",
+							Computed:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *APIKeysEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		e.client = client
+	}
+}
+
+func (e *APIKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data APIKeysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readProjectApiKeys(ctx, e.client, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "opened ephemeral API keys")
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/oapi-codegen/nullable"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccAPIKeysEphemeralResource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(apiKeysApiPath).
+		MatchParam("reveal", "true").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.ApiKeyResponse{
+			{
+				Name:   "anon",
+				Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypeLegacy),
+				ApiKey: nullable.NewNullableWithValue("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.anon"),
+			},
+			{
+				Name:   "service_role",
+				Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypeLegacy),
+				ApiKey: nullable.NewNullableWithValue("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.service_role"),
+			},
+			{
+				Name:   "publishable",
+				Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypePublishable),
+				ApiKey: nullable.NewNullableWithValue("sb_publishable_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
+			},
+			{
+				Name:   "secret",
+				Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypeSecret),
+				ApiKey: nullable.NewNullableWithValue("sb_secret_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
+			},
+		})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccEchoProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Open testing, values are only observable through the echo provider
+			{
+				Config: examples.APIKeysEphemeralResourceConfig + `
+provider "echo" {
+  data = ephemeral.supabase_apikeys.production
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("anon_key"), knownvalue.StringExact("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.anon")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("service_role_key"), knownvalue.StringExact("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.service_role")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("publishable_key"), knownvalue.StringExact("sb_publishable_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("secret_keys"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":    knownvalue.StringExact("secret"),
+							"api_key": knownvalue.StringExact("sb_secret_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BackupsDataSource{}
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
+
+// Defines the data source implementation.
+type BackupsDataSource struct {
+	client Client
+}
+
+// Describes the data source data model.
+type BackupsDataSourceModel struct {
+	ProjectRef         types.String         `tfsdk:"project_ref"`
+	Region             types.String         `tfsdk:"region"`
+	PitrEnabled        types.Bool           `tfsdk:"pitr_enabled"`
+	WalgEnabled        types.Bool           `tfsdk:"walg_enabled"`
+	EarliestRecoveryAt types.String         `tfsdk:"earliest_recovery_at"`
+	LatestRecoveryAt   types.String         `tfsdk:"latest_recovery_at"`
+	Backups            []BackupsBackupModel `tfsdk:"backups"`
+}
+
+// Describes a single backup in the list.
+type BackupsBackupModel struct {
+	Id               types.String `tfsdk:"id"`
+	InsertedAt       types.String `tfsdk:"inserted_at"`
+	Status           types.String `tfsdk:"status"`
+	IsPhysicalBackup types.Bool   `tfsdk:"is_physical_backup"`
+}
+
+func (d *BackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backups"
+}
+
+func (d *BackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Backups data source. Lists a project's backups and point in time recovery window.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region where the backups are stored",
+				Computed:            true,
+			},
+			"pitr_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether point in time recovery is enabled",
+				Computed:            true,
+			},
+			"walg_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether WAL-G physical backups are enabled",
+				Computed:            true,
+			},
+			"earliest_recovery_at": schema.StringAttribute{
+				MarkdownDescription: "Earliest point the project can be recovered to, as an RFC 3339 timestamp",
+				Computed:            true,
+			},
+			"latest_recovery_at": schema.StringAttribute{
+				MarkdownDescription: "Latest point the project can be recovered to, as an RFC 3339 timestamp",
+				Computed:            true,
+			},
+			"backups": schema.ListNestedAttribute{
+				MarkdownDescription: "Backups of the project",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Backup identifier, usable as `backup_id` of `supabase_project_restore`",
+							Computed:            true,
+						},
+						"inserted_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the backup was taken",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the backup, e.g. `COMPLETED`",
+							Computed:            true,
+						},
+						"is_physical_backup": schema.BoolAttribute{
+							MarkdownDescription: "Whether the backup is a physical backup",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		d.client = client
+	}
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := d.client.V1ListAllBackupsWithResponse(ctx, data.ProjectRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backups, got error: %s", err))
+		return
+	}
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backups, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	data.Region = types.StringValue(httpResp.JSON200.Region)
+	data.PitrEnabled = types.BoolValue(httpResp.JSON200.PitrEnabled)
+	data.WalgEnabled = types.BoolValue(httpResp.JSON200.WalgEnabled)
+	data.EarliestRecoveryAt = unixToTimestamp(httpResp.JSON200.PhysicalBackupData.EarliestPhysicalBackupDateUnix)
+	data.LatestRecoveryAt = unixToTimestamp(httpResp.JSON200.PhysicalBackupData.LatestPhysicalBackupDateUnix)
+
+	data.Backups = make([]BackupsBackupModel, 0, len(httpResp.JSON200.Backups))
+	for _, backup := range httpResp.JSON200.Backups {
+		data.Backups = append(data.Backups, BackupsBackupModel{
+			Id:               types.StringValue(backup.InsertedAt),
+			InsertedAt:       types.StringValue(backup.InsertedAt),
+			Status:           types.StringValue(string(backup.Status)),
+			IsPhysicalBackup: types.BoolValue(backup.IsPhysicalBackup),
+		})
+	}
+
+	tflog.Trace(ctx, "read backups data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func unixToTimestamp(seconds *int) types.String {
+	if seconds == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Unix(int64(*seconds), 0).UTC().Format(time.RFC3339))
+}
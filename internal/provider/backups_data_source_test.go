@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccBackupsDataSource(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(backupsApiPath).
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(testBackups(true))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: examples.BackupsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "project_ref", testProjectRef),
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "region", "us-east-1"),
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "pitr_enabled", "true"),
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "earliest_recovery_at", "2025-02-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "latest_recovery_at", "2025-03-08T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "backups.#", "2"),
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "backups.0.id", "2025-03-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "backups.0.status", "COMPLETED"),
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "backups.1.status", "FAILED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupsDataSource_Empty(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(backupsApiPath).
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"region":               "us-east-1",
+			"pitr_enabled":         false,
+			"walg_enabled":         true,
+			"backups":              []any{},
+			"physical_backup_data": map[string]any{},
+		})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: examples.BackupsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "pitr_enabled", "false"),
+					resource.TestCheckNoResourceAttr("data.supabase_backups.production", "earliest_recovery_at"),
+					resource.TestCheckResourceAttr("data.supabase_backups.production", "backups.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -25,7 +25,7 @@ func NewBranchDataSource() datasource.DataSource {
 
 // BranchDataSource defines the data source implementation.
 type BranchDataSource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 // BranchDataSourceModel describes the data source data model.
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &BranchListResource{}
+	_ list.ListResourceWithConfigure = &BranchListResource{}
+)
+
+func NewBranchListResource() list.ListResource {
+	return &BranchListResource{}
+}
+
+// BranchListResource defines the list resource implementation.
+type BranchListResource struct {
+	client Client
+}
+
+// BranchListResourceModel describes the list resource config model.
+type BranchListResourceModel struct {
+	ParentProjectRef types.String `tfsdk:"parent_project_ref"`
+}
+
+func (r *BranchListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch"
+}
+
+func (r *BranchListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the preview branches of a project, excluding the default branch",
+
+		Attributes: map[string]schema.Attribute{
+			"parent_project_ref": schema.StringAttribute{
+				MarkdownDescription: "Parent project ref",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *BranchListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *BranchListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config BranchListResourceModel
+
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	httpResp, err := r.client.V1ListAllBranchesWithResponse(ctx, config.ParentProjectRef.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list branches, got error: %s", err)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+	// Branching is disabled on the project
+	if httpResp.StatusCode() == http.StatusUnprocessableEntity {
+		stream.Results = list.NoListResults
+		return
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list branches, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+
+	branches := make([]api.BranchResponse, 0, len(*httpResp.JSON200))
+	for _, branch := range *httpResp.JSON200 {
+		if !branch.IsDefault {
+			branches = append(branches, branch)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, branches, func(branch api.BranchResponse, result *list.ListResult) {
+		data := BranchResourceModel{
+			Id: types.StringValue(branch.Id.String()),
+		}
+		result.DisplayName = branch.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, branchIdentity(&data))...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		data.Id = types.StringValue(branch.Id.String())
+		data.ParentProjectRef = types.StringValue(branch.ParentProjectRef)
+		data.GitBranch = types.StringPointerValue(branch.GitBranch)
+		data.Persistent = types.BoolValue(branch.Persistent)
+		if diags := readBranchDatabase(ctx, &data, r.client); diags.HasError() {
+			result.Diagnostics.Append(diags...)
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccBranchListResource(t *testing.T) {
+	defer gock.OffAll()
+
+	gock.New(defaultApiEndpoint).
+		Get(branchesApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.BranchResponse{
+			{
+				Id:               uuid.New(),
+				Name:             "main",
+				ParentProjectRef: testProjectRef,
+				IsDefault:        true,
+			},
+			{
+				Id:               uuid.MustParse(testBranchUUID),
+				Name:             "develop",
+				ParentProjectRef: testProjectRef,
+				GitBranch:        Ptr("develop"),
+			},
+		})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Query:  true,
+				Config: testAccQueryProviderConfig + examples.BranchListResourceConfig,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("supabase_branch.all", 1),
+					querycheck.ExpectIdentity("supabase_branch.all", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(testBranchUUID),
+					}),
+				},
+			},
+		},
+	})
+}
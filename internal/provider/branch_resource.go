@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -26,6 +27,7 @@ import (
 var (
 	_ resource.Resource                = &BranchResource{}
 	_ resource.ResourceWithImportState = &BranchResource{}
+	_ resource.ResourceWithIdentity    = &BranchResource{}
 )
 
 func NewBranchResource() resource.Resource {
@@ -34,7 +36,7 @@ func NewBranchResource() resource.Resource {
 
 // BranchResource defines the resource implementation.
 type BranchResource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 type BranchDatabaseModel struct {
@@ -71,6 +73,14 @@ type BranchResourceModel struct {
 	Id               types.String `tfsdk:"id"`
 }
 
+type BranchResourceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func branchIdentity(data *BranchResourceModel) BranchResourceIdentityModel {
+	return BranchResourceIdentityModel{Id: data.Id}
+}
+
 func (r *BranchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_branch"
 }
@@ -156,6 +166,17 @@ func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *BranchResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Branch project reference ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *BranchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
 		r.client = client
@@ -182,6 +203,7 @@ func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, branchIdentity(&data))...)
 }
 
 func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -200,6 +222,7 @@ func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, branchIdentity(&data))...)
 }
 
 func (r *BranchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -218,6 +241,7 @@ func (r *BranchResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, branchIdentity(&data))...)
 }
 
 func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -233,10 +257,10 @@ func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *BranchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
-func updateBranch(ctx context.Context, plan *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateBranch(ctx context.Context, plan *BranchResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1UpdateABranchConfigWithResponse(ctx, plan.Id.ValueString(), api.UpdateBranchBody{
 		BranchName: plan.GitBranch.ValueStringPointer(),
 		GitBranch:  plan.GitBranch.ValueStringPointer(),
@@ -262,14 +286,14 @@ func updateBranch(ctx context.Context, plan *BranchResourceModel, client *api.Cl
 	return nil
 }
 
-func readBranch(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readBranch(ctx context.Context, state *BranchResourceModel, client Client) diag.Diagnostics {
 	if diags := readBranchDatabase(ctx, state, client); diags.HasError() {
 		return diags
 	}
 	return readBranchPersistent(ctx, state, client)
 }
 
-func readBranchPersistent(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readBranchPersistent(ctx context.Context, state *BranchResourceModel, client Client) diag.Diagnostics {
 	if state.ParentProjectRef.IsNull() || state.Id.IsNull() {
 		return nil
 	}
@@ -291,7 +315,7 @@ func readBranchPersistent(ctx context.Context, state *BranchResourceModel, clien
 	return nil
 }
 
-func readBranchDatabase(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readBranchDatabase(ctx context.Context, state *BranchResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1GetABranchConfigWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read branch database, got error: %s", err)
@@ -302,11 +326,20 @@ func readBranchDatabase(ctx context.Context, state *BranchResourceModel, client
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
 	}
 
+	// Branches are served like projects under their own ref
+	host, user := httpResp.JSON200.DbHost, httpResp.JSON200.DbUser
+	if host == "" && httpResp.JSON200.Ref != "" {
+		host = projectDbHost(httpResp.JSON200.Ref)
+	}
+	if user == nil {
+		user = Ptr(defaultDatabaseUser)
+	}
+
 	database := BranchDatabaseModel{
 		Id:        types.StringValue(httpResp.JSON200.Ref),
-		Host:      types.StringValue(httpResp.JSON200.DbHost),
+		Host:      types.StringValue(host),
 		Port:      types.Int64Value(int64(httpResp.JSON200.DbPort)),
-		User:      types.StringPointerValue(httpResp.JSON200.DbUser),
+		User:      types.StringPointerValue(user),
 		Password:  types.StringPointerValue(httpResp.JSON200.DbPass),
 		JwtSecret: types.StringPointerValue(httpResp.JSON200.JwtSecret),
 		Version:   types.StringValue(httpResp.JSON200.PostgresVersion),
@@ -317,7 +350,7 @@ func readBranchDatabase(ctx context.Context, state *BranchResourceModel, client
 	return diag
 }
 
-func createBranch(ctx context.Context, plan *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func createBranch(ctx context.Context, plan *BranchResourceModel, client Client) diag.Diagnostics {
 	resp, err := client.V1ListAllBranchesWithResponse(ctx, plan.ParentProjectRef.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to enable branching, got error: %s", err)
@@ -369,7 +402,7 @@ func createBranch(ctx context.Context, plan *BranchResourceModel, client *api.Cl
 	return nil
 }
 
-func deleteBranch(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func deleteBranch(ctx context.Context, state *BranchResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1DeleteABranchWithResponse(ctx, state.Id.ValueString(), &api.V1DeleteABranchParams{})
 	if err != nil {
 		msg := fmt.Sprintf("Unable to delete branch, got error: %s", err)
@@ -393,7 +426,7 @@ func deleteBranch(ctx context.Context, state *BranchResourceModel, client *api.C
 	return nil
 }
 
-func demoteBranch(ctx context.Context, state *BranchResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func demoteBranch(ctx context.Context, state *BranchResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1UpdateABranchConfigWithResponse(ctx, state.Id.ValueString(), api.UpdateBranchBody{
 		Persistent: Ptr(false),
 	})
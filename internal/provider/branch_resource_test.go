@@ -4,11 +4,13 @@
 package provider
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/supabase/cli/pkg/api"
 	"github.com/supabase/terraform-provider-supabase/examples"
 	"gopkg.in/h2non/gock.v1"
@@ -153,3 +155,58 @@ func TestAccBranchResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBranchResource_FakeAPI(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	parentRef := fake.addProject("main")
+	config := func(persistent bool) string {
+		return fmt.Sprintf(`
+resource "supabase_branch" "new" {
+  parent_project_ref = "%s"
+  git_branch         = "develop"
+  persistent         = %t
+}
+`, parentRef, persistent)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { fake.preCheck(t) },
+		ProtoV6ProviderFactories: fake.providerFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			for _, rs := range s.RootModule().Resources {
+				ref := rs.Primary.Attributes["database.id"]
+				if status := fake.projectStatus(ref); status != "" {
+					return fmt.Errorf("branch project %s still exists with status %s", ref, status)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create waits for the branch database to come up
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("supabase_branch.new", "id"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "git_branch", "develop"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "persistent", "false"),
+					resource.TestCheckResourceAttrSet("supabase_branch.new", "database.id"),
+					resource.TestCheckResourceAttr("supabase_branch.new", "database.status", "ACTIVE_HEALTHY"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_branch.new", "persistent", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "supabase_branch.new",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"git_branch", "parent_project_ref", "persistent"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// clientMiddleware decorates the transport of the Management API client. All
+// operations of the generated client go through the transport, so a middleware
+// applies to every request without wrapping each operation of [Client].
+type clientMiddleware func(next api.HttpRequestDoer) api.HttpRequestDoer
+
+// doerFunc adapts a function to the api.HttpRequestDoer interface.
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// chainMiddlewares wraps doer so that the first middleware sees requests first.
+func chainMiddlewares(doer api.HttpRequestDoer, middlewares ...clientMiddleware) api.HttpRequestDoer {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		doer = middlewares[i](doer)
+	}
+	return doer
+}
+
+// loggingMiddleware logs each request with its outcome and duration.
+func loggingMiddleware(next api.HttpRequestDoer) api.HttpRequestDoer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next.Do(req)
+
+		fields := map[string]any{
+			"method":   req.Method,
+			"path":     req.URL.Path,
+			"duration": time.Since(start).String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+		}
+		tflog.Debug(req.Context(), "Management API request", fields)
+
+		return resp, err
+	})
+}
+
+// clientMetrics counts requests and their total latency, keyed by method and
+// status class such as "GET 2xx". Transport errors are counted as "GET error".
+// The running totals are logged after each request, so the debug log of a run
+// ends with a summary of the API calls it made.
+type clientMetrics struct {
+	mu       sync.Mutex
+	requests map[string]int
+	latency  map[string]time.Duration
+}
+
+func newClientMetrics() *clientMetrics {
+	return &clientMetrics{
+		requests: map[string]int{},
+		latency:  map[string]time.Duration{},
+	}
+}
+
+func (m *clientMetrics) middleware(next api.HttpRequestDoer) api.HttpRequestDoer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next.Do(req)
+
+		key := req.Method + " error"
+		if err == nil {
+			key = fmt.Sprintf("%s %dxx", req.Method, resp.StatusCode/100)
+		}
+		m.mu.Lock()
+		m.requests[key]++
+		m.latency[key] += time.Since(start)
+		fields := map[string]any{
+			"requests": m.requests[key],
+			"latency":  m.latency[key].String(),
+		}
+		m.mu.Unlock()
+		tflog.Debug(req.Context(), "Management API totals for "+key, fields)
+
+		return resp, err
+	})
+}
+
+// count returns the number of requests recorded under key.
+func (m *clientMetrics) count(key string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.requests[key]
+}
+
+type cachedResponse struct {
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// cachingMiddleware serves repeated successful GET requests from memory for up
+// to ttl. Any other request clears the cache, since it may change what later
+// reads return. Polling through a cache only observes changes once entries
+// expire, so ttl should stay well below the polling timeouts.
+func cachingMiddleware(ttl time.Duration) clientMiddleware {
+	var mu sync.Mutex
+	cache := map[string]cachedResponse{}
+
+	return func(next api.HttpRequestDoer) api.HttpRequestDoer {
+		return doerFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				mu.Lock()
+				clear(cache)
+				mu.Unlock()
+				return next.Do(req)
+			}
+
+			// Function bodies are served in different formats depending on Accept
+			key := req.URL.String() + " " + req.Header.Get("Accept")
+			mu.Lock()
+			entry, ok := cache[key]
+			mu.Unlock()
+			if ok && time.Now().Before(entry.expires) {
+				return &http.Response{
+					Status:        fmt.Sprintf("%d %s", entry.status, http.StatusText(entry.status)),
+					StatusCode:    entry.status,
+					Header:        entry.header.Clone(),
+					Body:          io.NopCloser(bytes.NewReader(entry.body)),
+					ContentLength: int64(len(entry.body)),
+					Request:       req,
+				}, nil
+			}
+
+			resp, err := next.Do(req)
+			if err != nil || resp.StatusCode != http.StatusOK {
+				return resp, err
+			}
+			body, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			mu.Lock()
+			cache[key] = cachedResponse{
+				status:  resp.StatusCode,
+				header:  resp.Header.Clone(),
+				body:    body,
+				expires: time.Now().Add(ttl),
+			}
+			mu.Unlock()
+
+			return resp, nil
+		})
+	}
+}
+
+// rateLimitMiddleware spaces requests evenly so that at most perMinute are
+// sent in any minute. Waiting requests give up when their context ends.
+func rateLimitMiddleware(perMinute int) clientMiddleware {
+	interval := time.Minute / time.Duration(perMinute)
+
+	var mu sync.Mutex
+	var nextSlot time.Time
+
+	return func(next api.HttpRequestDoer) api.HttpRequestDoer {
+		return doerFunc(func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			now := time.Now()
+			slot := nextSlot
+			if slot.Before(now) {
+				slot = now
+			}
+			nextSlot = slot.Add(interval)
+			mu.Unlock()
+
+			if wait := slot.Sub(now); wait > 0 {
+				timer := time.NewTimer(wait)
+				defer timer.Stop()
+				select {
+				case <-req.Context().Done():
+					return nil, req.Context().Err()
+				case <-timer.C:
+				}
+			}
+
+			return next.Do(req)
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// countingDoer answers every request with status and counts calls per method.
+type countingDoer struct {
+	status int
+	calls  map[string]int
+}
+
+func newCountingDoer(status int) *countingDoer {
+	return &countingDoer{status: status, calls: map[string]int{}}
+}
+
+func (d *countingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.calls[req.Method]++
+	return &http.Response{
+		StatusCode: d.status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"calls":%d}`, d.calls[req.Method]))),
+		Request:    req,
+	}, nil
+}
+
+func newTestRequest(t *testing.T, ctx context.Context, method string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, method, defaultApiEndpoint+projectApiPath, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	return req
+}
+
+func readBody(t *testing.T, resp *http.Response) string {
+	t.Helper()
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read body: %v", err)
+	}
+	return string(body)
+}
+
+func TestChainMiddlewares_Order(t *testing.T) {
+	var order []string
+	record := func(name string) clientMiddleware {
+		return func(next api.HttpRequestDoer) api.HttpRequestDoer {
+			return doerFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.Do(req)
+			})
+		}
+	}
+
+	doer := chainMiddlewares(newCountingDoer(http.StatusOK), record("first"), record("second"))
+	if _, err := doer.Do(newTestRequest(t, t.Context(), http.MethodGet)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Join(order, ",") != "first,second" {
+		t.Errorf("Expected middlewares to run in order, got %v", order)
+	}
+}
+
+func TestClientMetrics_CountsByMethodAndStatusClass(t *testing.T) {
+	metrics := newClientMetrics()
+	ok := metrics.middleware(newCountingDoer(http.StatusOK))
+	notFound := metrics.middleware(newCountingDoer(http.StatusNotFound))
+	failing := metrics.middleware(doerFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}))
+
+	for _, doer := range []api.HttpRequestDoer{ok, ok, notFound, failing} {
+		if resp, err := doer.Do(newTestRequest(t, t.Context(), http.MethodGet)); err == nil {
+			readBody(t, resp)
+		}
+	}
+
+	for key, expected := range map[string]int{"GET 2xx": 2, "GET 4xx": 1, "GET error": 1, "POST 2xx": 0} {
+		if count := metrics.count(key); count != expected {
+			t.Errorf("Expected %d requests for %q, got %d", expected, key, count)
+		}
+	}
+}
+
+func TestCachingMiddleware_ServesRepeatedGets(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		upstream := newCountingDoer(http.StatusOK)
+		doer := cachingMiddleware(time.Second)(upstream)
+
+		first, err := doer.Do(newTestRequest(t, t.Context(), http.MethodGet))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		second, err := doer.Do(newTestRequest(t, t.Context(), http.MethodGet))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if upstream.calls[http.MethodGet] != 1 {
+			t.Errorf("Expected second GET to be served from cache, got %d upstream calls", upstream.calls[http.MethodGet])
+		}
+		if a, b := readBody(t, first), readBody(t, second); a != b {
+			t.Errorf("Expected cached body %q, got %q", a, b)
+		}
+
+		// Entries expire after the ttl
+		time.Sleep(2 * time.Second)
+		if _, err := doer.Do(newTestRequest(t, t.Context(), http.MethodGet)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if upstream.calls[http.MethodGet] != 2 {
+			t.Errorf("Expected expired entry to be refetched, got %d upstream calls", upstream.calls[http.MethodGet])
+		}
+	})
+}
+
+func TestCachingMiddleware_WritesInvalidate(t *testing.T) {
+	upstream := newCountingDoer(http.StatusOK)
+	doer := cachingMiddleware(time.Minute)(upstream)
+
+	for _, method := range []string{http.MethodGet, http.MethodPatch, http.MethodGet} {
+		resp, err := doer.Do(newTestRequest(t, t.Context(), method))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		readBody(t, resp)
+	}
+	if upstream.calls[http.MethodGet] != 2 {
+		t.Errorf("Expected GET after a write to reach upstream, got %d upstream calls", upstream.calls[http.MethodGet])
+	}
+}
+
+func TestCachingMiddleware_SkipsErrors(t *testing.T) {
+	upstream := newCountingDoer(http.StatusServiceUnavailable)
+	doer := cachingMiddleware(time.Minute)(upstream)
+
+	for range 2 {
+		resp, err := doer.Do(newTestRequest(t, t.Context(), http.MethodGet))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		readBody(t, resp)
+	}
+	if upstream.calls[http.MethodGet] != 2 {
+		t.Errorf("Expected failed responses not to be cached, got %d upstream calls", upstream.calls[http.MethodGet])
+	}
+}
+
+func TestRateLimitMiddleware_SpacesRequests(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		doer := rateLimitMiddleware(60)(newCountingDoer(http.StatusOK))
+
+		start := time.Now()
+		for range 3 {
+			resp, err := doer.Do(newTestRequest(t, t.Context(), http.MethodGet))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			readBody(t, resp)
+		}
+		if elapsed := time.Since(start); elapsed != 2*time.Second {
+			t.Errorf("Expected 3 requests at 60 per minute to take 2s, took %s", elapsed)
+		}
+	})
+}
+
+func TestRateLimitMiddleware_ContextCanceled(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		doer := rateLimitMiddleware(1)(newCountingDoer(http.StatusOK))
+
+		resp, err := doer.Do(newTestRequest(t, t.Context(), http.MethodGet))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		readBody(t, resp)
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
+		if _, err := doer.Do(newTestRequest(t, ctx, http.MethodGet)); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected waiting request to give up with its context, got %v", err)
+		}
+	})
+}
+
+func TestProviderClientMiddlewares(t *testing.T) {
+	for name, tc := range map[string]struct {
+		data     SupabaseProviderModel
+		expected int
+		invalid  bool
+	}{
+		"unset": {
+			data:     SupabaseProviderModel{ReadCacheTtl: types.StringNull(), MaxRequestsPerMinute: types.Int64Null()},
+			expected: 2,
+		},
+		"cache and rate limit": {
+			data:     SupabaseProviderModel{ReadCacheTtl: types.StringValue("5s"), MaxRequestsPerMinute: types.Int64Value(120)},
+			expected: 4,
+		},
+		"invalid ttl": {
+			data:    SupabaseProviderModel{ReadCacheTtl: types.StringValue("soon"), MaxRequestsPerMinute: types.Int64Null()},
+			invalid: true,
+		},
+		"negative ttl": {
+			data:    SupabaseProviderModel{ReadCacheTtl: types.StringValue("-5s"), MaxRequestsPerMinute: types.Int64Null()},
+			invalid: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			middlewares, diags := providerClientMiddlewares(&tc.data)
+			if diags.HasError() != tc.invalid {
+				t.Fatalf("Expected error %v, got %v", tc.invalid, diags)
+			}
+			if !tc.invalid && len(middlewares) != tc.expected {
+				t.Errorf("Expected %d middlewares, got %d", tc.expected, len(middlewares))
+			}
+		})
+	}
+}
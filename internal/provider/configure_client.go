@@ -4,25 +4,92 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/supabase/cli/pkg/api"
 )
 
+// Client is the Management API client that resources, data sources and other
+// provider types depend on. It is implemented by the client from [newClient],
+// and unit tests can substitute a fake that embeds the interface and overrides
+// only the operations under test.
+type Client interface {
+	api.ClientWithResponsesInterface
+
+	// RemoveProjectAddonWithResponse removes a billing add-on by its variant.
+	// It replaces V1RemoveProjectAddonWithResponse, whose union typed
+	// addon_variant path parameter cannot be constructed outside the api package.
+	RemoveProjectAddonWithResponse(ctx context.Context, ref, addonVariant string, reqEditors ...api.RequestEditorFn) (*api.V1RemoveProjectAddonResponse, error)
+}
+
+// apiClient implements Client with the generated Management API client.
+type apiClient struct {
+	*api.ClientWithResponses
+	raw *api.Client
+}
+
+// newClient creates a Client for the Management API at server.
+func newClient(server string, opts ...api.ClientOption) (Client, error) {
+	raw, err := api.NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &apiClient{
+		ClientWithResponses: &api.ClientWithResponses{ClientInterface: raw},
+		raw:                 raw,
+	}, nil
+}
+
+func (c *apiClient) RemoveProjectAddonWithResponse(ctx context.Context, ref, addonVariant string, reqEditors ...api.RequestEditorFn) (*api.V1RemoveProjectAddonResponse, error) {
+	serverURL, err := url.Parse(c.raw.Server)
+	if err != nil {
+		return nil, err
+	}
+	operationPath := fmt.Sprintf("./v1/projects/%s/billing/addons/%s", url.PathEscape(ref), url.PathEscape(addonVariant))
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, edit := range c.raw.RequestEditors {
+		if err := edit(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	for _, edit := range reqEditors {
+		if err := edit(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	rsp, err := c.raw.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	return api.ParseV1RemoveProjectAddonResponse(rsp)
+}
+
 // extractClient extracts the API client from provider data.
 // Returns the client and true if successful, nil and false otherwise.
 // Adds an error to diagnostics if the provider data is not the expected type.
-func extractClient(providerData any, diagnostics *diag.Diagnostics) (*api.ClientWithResponses, bool) {
+func extractClient(providerData any, diagnostics *diag.Diagnostics) (Client, bool) {
 	if providerData == nil {
 		return nil, false
 	}
 
-	client, ok := providerData.(*api.ClientWithResponses)
+	client, ok := providerData.(Client)
 	if !ok {
 		diagnostics.AddError(
 			"Unexpected Configure Type",
-			fmt.Sprintf("Expected *api.ClientWithResponses, got: %T. Please report this issue to the provider developers.", providerData),
+			fmt.Sprintf("Expected a Management API client, got: %T. Please report this issue to the provider developers.", providerData),
 		)
 		return nil, false
 	}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// fakeProjectClient serves project reads from memory. Embedding Client leaves
+// every other operation unimplemented, which panics if a test reaches it.
+type fakeProjectClient struct {
+	Client
+	statuses []api.V1ProjectWithDatabaseResponseStatus
+	reads    int
+}
+
+func (c *fakeProjectClient) V1GetProjectWithResponse(ctx context.Context, ref string, reqEditors ...api.RequestEditorFn) (*api.V1GetProjectResponse, error) {
+	status := c.statuses[min(c.reads, len(c.statuses)-1)]
+	c.reads++
+	return &api.V1GetProjectResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200: &api.V1ProjectWithDatabaseResponse{
+			Id:     ref,
+			Status: status,
+		},
+	}, nil
+}
+
+func TestExtractClient(t *testing.T) {
+	var diags diag.Diagnostics
+
+	fake := &fakeProjectClient{}
+	if client, ok := extractClient(fake, &diags); !ok || client != fake {
+		t.Errorf("Expected fake client to be accepted, got %v, %v", client, diags)
+	}
+
+	if _, ok := extractClient("not a client", &diags); ok || !diags.HasError() {
+		t.Errorf("Expected unexpected provider data to be rejected")
+	}
+}
+
+func TestWaitForProjectPaused_FakeClient(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		client := &fakeProjectClient{statuses: []api.V1ProjectWithDatabaseResponseStatus{
+			api.V1ProjectWithDatabaseResponseStatusPAUSING,
+			api.V1ProjectWithDatabaseResponseStatusPAUSING,
+			api.V1ProjectWithDatabaseResponseStatusINACTIVE,
+		}}
+
+		diags := waitForProjectPaused(t.Context(), testProjectRef, client, 5*time.Minute)
+		if diags.HasError() {
+			t.Fatalf("Expected success once project is paused, got errors: %v", diags)
+		}
+		if client.reads != 3 {
+			t.Errorf("Expected 3 project reads, got %d", client.reads)
+		}
+	})
+}
+
+func TestWaitForProjectPaused_FakeClientPauseFailed(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		client := &fakeProjectClient{statuses: []api.V1ProjectWithDatabaseResponseStatus{
+			api.V1ProjectWithDatabaseResponseStatusPAUSEFAILED,
+		}}
+
+		diags := waitForProjectPaused(t.Context(), testProjectRef, client, 5*time.Minute)
+		if !diags.HasError() {
+			t.Errorf("Expected error for failed pause, got success")
+		}
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ConnectionStringFunction{}
+
+const (
+	connectionModeTransaction = "transaction"
+	connectionModeSession     = "session"
+	connectionModeDirect      = "direct"
+)
+
+func NewConnectionStringFunction() function.Function {
+	return &ConnectionStringFunction{}
+}
+
+// ConnectionStringFunction defines the function implementation.
+type ConnectionStringFunction struct{}
+
+func (f *ConnectionStringFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "connection_string"
+}
+
+func (f *ConnectionStringFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a Postgres connection string for a project",
+		MarkdownDescription: "Builds a Postgres connection string for the `postgres` user of a project or branch.\n\n" +
+			"Without IPv4, `direct` and `session` connect to `db.<project_ref>.supabase.co:5432` and `transaction` connects to the dedicated pooler on port `6543`. " +
+			"With IPv4, `session` and `transaction` connect through the shared pooler on ports `5432` and `6543` respectively, " +
+			"which requires passing the region specific pooler host, e.g. `aws-0-us-east-1.pooler.supabase.com` as shown in the connection strings of the `supabase_pooler` data source. " +
+			"Direct connections are only reachable over IPv4 with the IPv4 add-on, so `ipv4` has no effect on them.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ref",
+				MarkdownDescription: "Project or branch reference ID",
+			},
+			function.StringParameter{
+				Name:                "password",
+				MarkdownDescription: "Password of the `postgres` user",
+			},
+			function.StringParameter{
+				Name:                "mode",
+				MarkdownDescription: "Connection mode, one of `transaction`, `session` or `direct`",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(connectionModeTransaction, connectionModeSession, connectionModeDirect),
+				},
+			},
+			function.BoolParameter{
+				Name:                "ipv4",
+				MarkdownDescription: "Whether to connect through the IPv4 compatible shared pooler",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "pooler_host",
+			MarkdownDescription: "Host of the shared pooler, required when `ipv4` is `true` and `mode` is not `direct`",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ConnectionStringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ref, password, mode string
+	var ipv4 bool
+	var poolerHosts []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ref, &password, &mode, &ipv4, &poolerHosts))
+	if resp.Error != nil {
+		return
+	}
+
+	host := projectDbHost(ref)
+	port := defaultDatabasePort
+	user := defaultDatabaseUser
+
+	if ipv4 && mode != connectionModeDirect {
+		if len(poolerHosts) != 1 || poolerHosts[0] == "" {
+			resp.Error = function.NewArgumentFuncError(4, "Exactly one pooler_host must be provided for IPv4 pooler connections")
+			return
+		}
+		host = poolerHosts[0]
+		user = poolerUser(defaultDatabaseUser, ref)
+	}
+	if mode == connectionModeTransaction {
+		port = poolerTransactionPort
+	}
+
+	connection := postgresUrl(url.UserPassword(user, password), host, port, defaultDatabaseName)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, connection))
+}
@@ -20,6 +20,18 @@ const (
 	storageConfigApiPath       = projectApiPath + "/config/storage"
 	sslEnforcementApiPath      = projectApiPath + "/ssl-enforcement"
 	secretsApiPath             = projectApiPath + "/secrets"
+	diskApiPath                = projectApiPath + "/config/disk"
+	diskAutoscaleApiPath       = diskApiPath + "/autoscale"
+	readReplicaSetupApiPath    = projectApiPath + "/read-replicas/setup"
+	readReplicaRemoveApiPath   = projectApiPath + "/read-replicas/remove"
+	backupsApiPath             = projectApiPath + "/database/backups"
+	restorePitrApiPath         = backupsApiPath + "/restore-pitr"
+	vanitySubdomainApiPath     = projectApiPath + "/vanity-subdomain"
+	customHostnameApiPath      = projectApiPath + "/custom-hostname"
+	signingKeysApiPath         = authConfigApiPath + "/signing-keys"
+	loginRoleApiPath           = projectApiPath + "/cli/login-role"
+
+	organizationProjectsApiPath = "/v1/organizations/continued-brown-smelt/projects"
 
 	// A branch ref resolves on /v1/branches/{ref} but returns 404 on /v1/projects/{ref}.
 	testBranchRef           = "zyxwvutsrqponmlkjihg" //nolint:gosec
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &CustomHostnameActivationResource{}
+	_ resource.ResourceWithImportState = &CustomHostnameActivationResource{}
+	_ resource.ResourceWithIdentity    = &CustomHostnameActivationResource{}
+)
+
+func NewCustomHostnameActivationResource() resource.Resource {
+	return &CustomHostnameActivationResource{}
+}
+
+// CustomHostnameActivationResource defines the resource implementation.
+type CustomHostnameActivationResource struct {
+	client Client
+}
+
+// CustomHostnameActivationResourceModel describes the resource data model.
+type CustomHostnameActivationResourceModel struct {
+	ProjectRef     types.String   `tfsdk:"project_ref"`
+	CustomHostname types.String   `tfsdk:"custom_hostname"`
+	Status         types.String   `tfsdk:"status"`
+	SslStatus      types.String   `tfsdk:"ssl_status"`
+	Id             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CustomHostnameActivationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_hostname_activation"
+}
+
+func (r *CustomHostnameActivationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom hostname activation resource. Waits for DNS and SSL verification of a hostname registered with " +
+			"`supabase_custom_hostname`, then switches the project over to it. Make it depend on the DNS records created from the " +
+			"outputs of `supabase_custom_hostname`, so both can be applied in a single run. Destroying this resource does not " +
+			"deactivate the hostname, destroy the `supabase_custom_hostname` to stop serving it.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_hostname": schema.StringAttribute{
+				MarkdownDescription: "Registered hostname to activate, e.g. `supabase_custom_hostname.api.custom_hostname`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Verification status of the custom hostname",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssl_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the SSL certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Project identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomHostnameActivationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectRefIdentitySchema()
+}
+
+func (r *CustomHostnameActivationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *CustomHostnameActivationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomHostnameActivationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the hostname registered for the project can be activated
+	hostname, diags := getCustomHostnameConfig(ctx, data.ProjectRef.ValueString(), r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if hostname == nil || hostname.CustomHostname != data.CustomHostname.ValueString() {
+		resp.Diagnostics.AddError(
+			"Custom Hostname Not Registered",
+			fmt.Sprintf("Custom hostname %s is not registered for project %s, create it with supabase_custom_hostname first",
+				data.CustomHostname.ValueString(), data.ProjectRef.ValueString()),
+		)
+		return
+	}
+
+	config, diags := activateCustomHostname(ctx, data.ProjectRef.ValueString(), r.client, createTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setCustomHostnameActivationAttributes(&data, config)
+
+	tflog.Trace(ctx, "activated custom hostname")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *CustomHostnameActivationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomHostnameActivationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readCustomHostnameActivation(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read custom hostname activation")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *CustomHostnameActivationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomHostnameActivationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every configurable attribute requires replacement, only timeouts can change here.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *CustomHostnameActivationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomHostnameActivationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Simply fallthrough since an active hostname can only be removed together with its registration.
+}
+
+func (r *CustomHostnameActivationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectRef, diags := importProjectRef(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := CustomHostnameActivationResourceModel{
+		ProjectRef:     types.StringValue(projectRef),
+		CustomHostname: types.StringNull(),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+			}),
+		},
+	}
+
+	found, diags := readCustomHostnameActivation(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("Project %s has no active custom hostname", projectRef),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func setCustomHostnameActivationAttributes(data *CustomHostnameActivationResourceModel, config *api.UpdateCustomHostnameResponse) {
+	data.Id = data.ProjectRef
+	data.CustomHostname = types.StringValue(config.CustomHostname)
+	data.Status = types.StringValue(string(config.Status))
+	data.SslStatus = types.StringValue(config.Data.Result.Ssl.Status)
+}
+
+func readCustomHostnameActivation(ctx context.Context, data *CustomHostnameActivationResourceModel, client Client) (bool, diag.Diagnostics) {
+	config, diags := getCustomHostnameConfig(ctx, data.ProjectRef.ValueString(), client)
+	if diags.HasError() || config == nil {
+		return false, diags
+	}
+
+	// A replaced or no longer active hostname has to be activated again
+	if config.Status != api.N5ServicesReconfigured ||
+		(!data.CustomHostname.IsNull() && config.CustomHostname != data.CustomHostname.ValueString()) {
+		tflog.Trace(ctx, fmt.Sprintf("custom hostname not active: %s", config.Status))
+		return false, nil
+	}
+
+	setCustomHostnameActivationAttributes(data, config)
+	return true, nil
+}
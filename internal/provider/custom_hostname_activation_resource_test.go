@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func customHostnameActivationResourceConfig(timeout string) string {
+	return fmt.Sprintf(`
+resource "supabase_custom_hostname" "api" {
+  project_ref     = %q
+  custom_hostname = "api.example.com"
+}
+
+resource "supabase_custom_hostname_activation" "api" {
+  project_ref     = supabase_custom_hostname.api.project_ref
+  custom_hostname = supabase_custom_hostname.api.custom_hostname
+
+  timeouts {
+    create = %q
+  }
+}
+`, testProjectRef, timeout)
+}
+
+func TestAccCustomHostnameActivationResource(t *testing.T) {
+	defer gock.OffAll()
+	var activated atomic.Bool
+	// Register the hostname and activate it within the same apply
+	gock.New(defaultApiEndpoint).
+		Post(customHostnameApiPath + "/initialize").
+		Reply(http.StatusCreated).
+		JSON(testCustomHostname(api.N2Initiated, "pending_validation"))
+	gock.New(defaultApiEndpoint).
+		Post(customHostnameApiPath + "/reverify").
+		Reply(http.StatusCreated).
+		JSON(testCustomHostname(api.N2Initiated, "pending_validation", "custom hostname does not CNAME to this zone."))
+	gock.New(defaultApiEndpoint).
+		Post(customHostnameApiPath + "/reverify").
+		Reply(http.StatusCreated).
+		JSON(testCustomHostname(api.N3ChallengeVerified, "active"))
+	gock.New(defaultApiEndpoint).
+		Post(customHostnameApiPath + "/activate").
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { activated.Store(true); return true, nil }).
+		Reply(http.StatusCreated).
+		JSON(testCustomHostname(api.N5ServicesReconfigured, "active"))
+	gock.New(defaultApiEndpoint).
+		Get(customHostnameApiPath).
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return activated.Load(), nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testCustomHostname(api.N5ServicesReconfigured, "active"))
+	gock.New(defaultApiEndpoint).
+		Get(customHostnameApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testCustomHostname(api.N2Initiated, "pending_validation"))
+	// Delete
+	gock.New(defaultApiEndpoint).
+		Delete(customHostnameApiPath).
+		Reply(http.StatusOK)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.CustomHostnameActivationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_custom_hostname_activation.api", "id", testProjectRef),
+					resource.TestCheckResourceAttr("supabase_custom_hostname_activation.api", "custom_hostname", "api.example.com"),
+					resource.TestCheckResourceAttr("supabase_custom_hostname_activation.api", "status", "5_services_reconfigured"),
+					resource.TestCheckResourceAttr("supabase_custom_hostname_activation.api", "ssl_status", "active"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_custom_hostname_activation.api",
+				ImportState:       true,
+				ImportStateId:     testProjectRef,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCustomHostnameActivationResource_VerificationTimeout(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Post(customHostnameApiPath + "/initialize").
+		Reply(http.StatusCreated).
+		JSON(testCustomHostname(api.N2Initiated, "pending_validation"))
+	gock.New(defaultApiEndpoint).
+		Get(customHostnameApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testCustomHostname(api.N2Initiated, "pending_validation"))
+	gock.New(defaultApiEndpoint).
+		Post(customHostnameApiPath + "/reverify").
+		Persist().
+		Reply(http.StatusCreated).
+		JSON(testCustomHostname(api.N2Initiated, "pending_validation", "custom hostname does not CNAME to this zone."))
+	gock.New(defaultApiEndpoint).
+		Delete(customHostnameApiPath).
+		Reply(http.StatusOK)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      customHostnameActivationResourceConfig("1s"),
+				ExpectError: regexp.MustCompile(`does not CNAME to this zone`),
+			},
+		},
+	})
+}
+
+func TestAccCustomHostnameActivationResource_NotRegistered(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(customHostnameApiPath).
+		Reply(http.StatusOK).
+		JSON(testCustomHostname(api.N1NotStarted, "pending_validation"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_custom_hostname_activation" "api" {
+  project_ref     = %q
+  custom_hostname = "api.example.com"
+}
+`, testProjectRef),
+				ExpectError: regexp.MustCompile(`Custom Hostname Not Registered`),
+			},
+		},
+	})
+}
@@ -0,0 +1,455 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &CustomHostnameResource{}
+	_ resource.ResourceWithImportState = &CustomHostnameResource{}
+	_ resource.ResourceWithIdentity    = &CustomHostnameResource{}
+)
+
+const (
+	customHostnameStatusVerified = "CUSTOM_HOSTNAME_STATUS_VERIFIED"
+	customHostnameSslActive      = "active"
+)
+
+func NewCustomHostnameResource() resource.Resource {
+	return &CustomHostnameResource{}
+}
+
+// CustomHostnameResource defines the resource implementation.
+type CustomHostnameResource struct {
+	client Client
+}
+
+// CustomHostnameResourceModel describes the resource data model.
+type CustomHostnameResourceModel struct {
+	ProjectRef            types.String `tfsdk:"project_ref"`
+	CustomHostname        types.String `tfsdk:"custom_hostname"`
+	CnameTarget           types.String `tfsdk:"cname_target"`
+	OwnershipVerification types.Object `tfsdk:"ownership_verification"`
+	SslValidationRecords  types.List   `tfsdk:"ssl_validation_records"`
+	Status                types.String `tfsdk:"status"`
+	SslStatus             types.String `tfsdk:"ssl_status"`
+	Id                    types.String `tfsdk:"id"`
+}
+
+var customHostnameRecordType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"type":  types.StringType,
+	"name":  types.StringType,
+	"value": types.StringType,
+}}
+
+func (r *CustomHostnameResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_hostname"
+}
+
+func (r *CustomHostnameResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	recordAttributes := map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			MarkdownDescription: "DNS record type",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "DNS record name",
+			Computed:            true,
+		},
+		"value": schema.StringAttribute{
+			MarkdownDescription: "DNS record value",
+			Computed:            true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom hostname resource. Registers a custom domain for a project's API and exposes the DNS records required to verify it. " +
+			"Create the records with your DNS provider, then use `supabase_custom_hostname_activation` to wait for verification and switch the project over to the hostname.",
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname to serve the project's API from, e.g. `api.example.com`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cname_target": schema.StringAttribute{
+				MarkdownDescription: "Target of the CNAME record to create for `custom_hostname`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ownership_verification": schema.SingleNestedAttribute{
+				MarkdownDescription: "DNS record proving ownership of `custom_hostname`",
+				Computed:            true,
+				Attributes:          recordAttributes,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssl_validation_records": schema.ListNestedAttribute{
+				MarkdownDescription: "TXT records required to issue the SSL certificate",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: recordAttributes,
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Verification status of the custom hostname",
+				Computed:            true,
+			},
+			"ssl_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the SSL certificate",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Project identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomHostnameResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectRefIdentitySchema()
+}
+
+func (r *CustomHostnameResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *CustomHostnameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomHostnameResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.V1UpdateHostnameConfigWithResponse(ctx, data.ProjectRef.ValueString(), api.V1UpdateHostnameConfigJSONRequestBody{
+		CustomHostname: data.CustomHostname.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom hostname, got error: %s", err))
+		return
+	}
+	if httpResp.JSON201 == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom hostname, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+	resp.Diagnostics.Append(setCustomHostnameAttributes(&data, httpResp.JSON201)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created custom hostname")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *CustomHostnameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomHostnameResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readCustomHostname(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read custom hostname")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *CustomHostnameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomHostnameResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every configurable attribute requires replacement, so there is nothing to update.
+	found, diags := readCustomHostname(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Custom hostname of project %s no longer exists", data.ProjectRef.ValueString()))
+		return
+	}
+
+	tflog.Trace(ctx, "updated custom hostname")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *CustomHostnameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomHostnameResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.V1DeleteHostnameConfigWithResponse(ctx, data.ProjectRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom hostname, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("custom hostname not found: %s", data.ProjectRef.ValueString()))
+		return
+	}
+	if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom hostname, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted custom hostname")
+}
+
+func (r *CustomHostnameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectRef, diags := importProjectRef(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := CustomHostnameResourceModel{
+		ProjectRef:     types.StringValue(projectRef),
+		CustomHostname: types.StringNull(),
+	}
+
+	found, diags := readCustomHostname(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("Project %s has no custom hostname", projectRef),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func setCustomHostnameAttributes(data *CustomHostnameResourceModel, config *api.UpdateCustomHostnameResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	result := config.Data.Result
+
+	data.Id = data.ProjectRef
+	data.CustomHostname = types.StringValue(config.CustomHostname)
+	data.CnameTarget = types.StringValue(result.CustomOriginServer)
+	data.Status = types.StringValue(string(config.Status))
+	data.SslStatus = types.StringValue(result.Ssl.Status)
+
+	ownership, d := types.ObjectValue(customHostnameRecordType.AttrTypes, map[string]attr.Value{
+		"type":  types.StringValue(result.OwnershipVerification.Type),
+		"name":  types.StringValue(result.OwnershipVerification.Name),
+		"value": types.StringValue(result.OwnershipVerification.Value),
+	})
+	diags.Append(d...)
+	data.OwnershipVerification = ownership
+
+	records := make([]attr.Value, 0, len(result.Ssl.ValidationRecords))
+	for _, record := range result.Ssl.ValidationRecords {
+		obj, d := types.ObjectValue(customHostnameRecordType.AttrTypes, map[string]attr.Value{
+			"type":  types.StringValue("txt"),
+			"name":  types.StringValue(record.TxtName),
+			"value": types.StringValue(record.TxtValue),
+		})
+		diags.Append(d...)
+		records = append(records, obj)
+	}
+	list, d := types.ListValue(customHostnameRecordType, records)
+	diags.Append(d...)
+	data.SslValidationRecords = list
+
+	return diags
+}
+
+// getCustomHostnameConfig returns the hostname registered for a project, or nil when there is none.
+func getCustomHostnameConfig(ctx context.Context, projectRef string, client Client) (*api.UpdateCustomHostnameResponse, diag.Diagnostics) {
+	httpResp, err := client.V1GetHostnameConfigWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read custom hostname, got error: %s", err)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("custom hostname not found: %s", projectRef))
+		return nil, nil
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read custom hostname, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200.Status == api.N1NotStarted {
+		tflog.Trace(ctx, fmt.Sprintf("custom hostname not started: %s", projectRef))
+		return nil, nil
+	}
+	return httpResp.JSON200, nil
+}
+
+func readCustomHostname(ctx context.Context, data *CustomHostnameResourceModel, client Client) (bool, diag.Diagnostics) {
+	config, diags := getCustomHostnameConfig(ctx, data.ProjectRef.ValueString(), client)
+	if diags.HasError() || config == nil {
+		return false, diags
+	}
+	return true, setCustomHostnameAttributes(data, config)
+}
+
+// customHostnameErrors collects the verification and SSL validation errors reported for a hostname.
+func customHostnameErrors(config *api.UpdateCustomHostnameResponse) error {
+	var errs []error
+	if config.Data.Result.VerificationErrors != nil {
+		for _, msg := range *config.Data.Result.VerificationErrors {
+			errs = append(errs, errors.New(msg))
+		}
+	}
+	if config.Data.Result.Ssl.ValidationErrors != nil {
+		for _, v := range *config.Data.Result.Ssl.ValidationErrors {
+			errs = append(errs, errors.New(v.Message))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// waitForCustomHostnameVerified polls reverification until ownership is verified and the SSL certificate is active.
+func waitForCustomHostnameVerified(ctx context.Context, projectRef string, client Client, timeout time.Duration) (*api.UpdateCustomHostnameResponse, diag.Diagnostics) {
+	// Verification errors are expected while DNS propagates, keep the latest for the timeout message
+	var lastErr error
+	stateConf := &retry.StateChangeConf{
+		Timeout: timeout,
+		Pending: []string{
+			string(api.N2Initiated),
+			string(api.N3ChallengeVerified),
+		},
+		Target: []string{customHostnameStatusVerified},
+		Refresh: func() (any, string, error) {
+			resp, err := client.V1VerifyDnsConfigWithResponse(ctx, projectRef)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to reverify custom hostname: %w", err)
+			}
+			if resp.JSON201 == nil {
+				return nil, "", fmt.Errorf("unexpected status %d: %s", resp.StatusCode(), resp.Body)
+			}
+			config := resp.JSON201
+			lastErr = customHostnameErrors(config)
+			tflog.Debug(ctx, "Waiting for custom hostname to be verified", map[string]any{
+				"project_ref": projectRef,
+				"status":      config.Status,
+				"ssl_status":  config.Data.Result.Ssl.Status,
+				"errors":      lastErr,
+			})
+
+			switch config.Status {
+			case api.N1NotStarted:
+				return nil, "", fmt.Errorf("custom hostname %s was removed", config.CustomHostname)
+			case api.N4OriginSetupCompleted, api.N5ServicesReconfigured:
+				return config, customHostnameStatusVerified, nil
+			}
+			if config.Data.Result.Ssl.Status == customHostnameSslActive && config.Status == api.N3ChallengeVerified {
+				return config, customHostnameStatusVerified, nil
+			}
+			return config, string(config.Status), nil
+		},
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if lastErr != nil {
+			err = fmt.Errorf("%w: %w", err, lastErr)
+		}
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Custom Hostname Not Verified",
+			fmt.Sprintf("Custom hostname of project %s was not verified within timeout: %s", projectRef, err),
+		)}
+	}
+	return result.(*api.UpdateCustomHostnameResponse), nil
+}
+
+// activateCustomHostname waits for the custom hostname to be verified, then activates it.
+func activateCustomHostname(ctx context.Context, projectRef string, client Client, timeout time.Duration) (*api.UpdateCustomHostnameResponse, diag.Diagnostics) {
+	config, diags := waitForCustomHostnameVerified(ctx, projectRef, client, timeout)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if config.Status != api.N5ServicesReconfigured {
+		httpResp, err := client.V1ActivateCustomHostnameWithResponse(ctx, projectRef)
+		if err != nil {
+			msg := fmt.Sprintf("Unable to activate custom hostname, got error: %s", err)
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		if httpResp.JSON201 == nil {
+			msg := fmt.Sprintf("Unable to activate custom hostname, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+		config = httpResp.JSON201
+	}
+
+	return config, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// testCustomHostname returns a custom hostname config in the given verification state.
+func testCustomHostname(status api.UpdateCustomHostnameResponseStatus, sslStatus string, verificationErrors ...string) map[string]any {
+	return map[string]any{
+		"custom_hostname": "api.example.com",
+		"status":          status,
+		"data": map[string]any{
+			"success":  true,
+			"errors":   []any{},
+			"messages": []any{},
+			"result": map[string]any{
+				"id":                   "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+				"hostname":             "api.example.com",
+				"custom_origin_server": testProjectRef + ".supabase.co",
+				"status":               "pending",
+				"ownership_verification": map[string]any{
+					"type":  "txt",
+					"name":  "_cf-custom-hostname.api.example.com",
+					"value": "5cc1ef4b-8e27-4d34-9d11-0e4c2b4a4c7a",
+				},
+				"ssl": map[string]any{
+					"status": sslStatus,
+					"validation_records": []map[string]any{
+						{"txt_name": "_acme-challenge.api.example.com", "txt_value": "ca3-574923932a82475cb8592200f1a2a23d"},
+					},
+				},
+				"verification_errors": verificationErrors,
+			},
+		},
+	}
+}
+
+func TestAccCustomHostnameResource(t *testing.T) {
+	defer gock.OffAll()
+	// Step 1: register
+	gock.New(defaultApiEndpoint).
+		Post(customHostnameApiPath + "/initialize").
+		AddMatcher(matchJSONBodyField("custom_hostname", "api.example.com")).
+		Reply(http.StatusCreated).
+		JSON(testCustomHostname(api.N2Initiated, "pending_validation"))
+	gock.New(defaultApiEndpoint).
+		Get(customHostnameApiPath).
+		Times(5).
+		Reply(http.StatusOK).
+		JSON(testCustomHostname(api.N2Initiated, "pending_validation"))
+	// Delete
+	gock.New(defaultApiEndpoint).
+		Delete(customHostnameApiPath).
+		Reply(http.StatusOK)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.CustomHostnameResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_custom_hostname.api", "id", testProjectRef),
+					resource.TestCheckResourceAttr("supabase_custom_hostname.api", "status", "2_initiated"),
+					resource.TestCheckResourceAttr("supabase_custom_hostname.api", "cname_target", testProjectRef+".supabase.co"),
+					resource.TestCheckResourceAttr("supabase_custom_hostname.api", "ownership_verification.name", "_cf-custom-hostname.api.example.com"),
+					resource.TestCheckResourceAttr("supabase_custom_hostname.api", "ssl_validation_records.#", "1"),
+					resource.TestCheckResourceAttr("supabase_custom_hostname.api", "ssl_validation_records.0.name", "_acme-challenge.api.example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_custom_hostname.api",
+				ImportState:       true,
+				ImportStateId:     testProjectRef,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
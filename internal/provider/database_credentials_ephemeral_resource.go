@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &DatabaseCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &DatabaseCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &DatabaseCredentialsEphemeralResource{}
+)
+
+const (
+	databaseConnectionDirect = "direct"
+	databaseConnectionPooler = "pooler"
+
+	// Private data key holding the project ref, so Close can remove the login role.
+	databaseCredentialsPrivateKey = "project_ref"
+)
+
+func NewDatabaseCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &DatabaseCredentialsEphemeralResource{}
+}
+
+// DatabaseCredentialsEphemeralResource defines the ephemeral resource implementation.
+type DatabaseCredentialsEphemeralResource struct {
+	client Client
+}
+
+// DatabaseCredentialsEphemeralResourceModel describes the ephemeral resource data model.
+type DatabaseCredentialsEphemeralResourceModel struct {
+	ProjectRef       types.String `tfsdk:"project_ref"`
+	ReadOnly         types.Bool   `tfsdk:"read_only"`
+	ConnectionType   types.String `tfsdk:"connection_type"`
+	Role             types.String `tfsdk:"role"`
+	Password         types.String `tfsdk:"password"`
+	TtlSeconds       types.Int64  `tfsdk:"ttl_seconds"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	Host             types.String `tfsdk:"host"`
+	Port             types.Int64  `tfsdk:"port"`
+	Database         types.String `tfsdk:"database"`
+	User             types.String `tfsdk:"user"`
+	ConnectionString types.String `tfsdk:"connection_string"`
+}
+
+func (e *DatabaseCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_credentials"
+}
+
+func (e *DatabaseCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Temporary database credentials ephemeral resource. Issues a short-lived Postgres login role for a project or branch, " +
+			"which is removed again when Terraform closes the ephemeral resource. Credentials are never persisted to plan or state.\n\n" +
+			"~> **WARNING:** Closing the ephemeral resource deletes every temporary login role on the project, not only the one it issued, " +
+			"because the Management API can only remove them all at once. Do not open more than one instance against the same project at the same time, " +
+			"or run `supabase` CLI sessions that use temporary login roles alongside it, as their credentials are revoked when the first one closes.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project or branch reference ID",
+				Required:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether the login role is restricted to read-only access. Defaults to `false`.",
+				Optional:            true,
+			},
+			"connection_type": schema.StringAttribute{
+				MarkdownDescription: "Type of connection string to return, either `pooler` (Supavisor) or `direct`. Defaults to `pooler`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(databaseConnectionPooler, databaseConnectionDirect),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Name of the temporary Postgres role",
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the temporary Postgres role",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds the temporary role remains valid",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Time at which the temporary role expires, in RFC 3339 format",
+				Computed:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Database host to connect to",
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Database port to connect to",
+				Computed:            true,
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Database name to connect to",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User name to connect with",
+				Computed:            true,
+			},
+			"connection_string": schema.StringAttribute{
+				MarkdownDescription: "Ready to use Postgres connection string, including the password",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *DatabaseCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		e.client = client
+	}
+}
+
+func (e *DatabaseCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DatabaseCredentialsEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ConnectionType.IsNull() {
+		data.ConnectionType = types.StringValue(databaseConnectionPooler)
+	}
+
+	// Resolve the endpoint before issuing credentials, Close is not called when Open fails
+	projectRef := data.ProjectRef.ValueString()
+	endpoint, diags := getDatabaseEndpoint(ctx, e.client, projectRef, data.ConnectionType.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := e.client.V1CreateLoginRoleWithResponse(ctx, projectRef, api.CreateRoleBody{
+		ReadOnly: data.ReadOnly.ValueBool(),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to create database credentials, got error: %s", err)
+		resp.Diagnostics.AddError("Client Error", msg)
+		return
+	}
+	if httpResp.JSON201 == nil {
+		msg := fmt.Sprintf("Unable to create database credentials, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		resp.Diagnostics.AddError("Client Error", msg)
+		return
+	}
+
+	// Record the project ref so Close can remove the login role
+	privateRef, err := json.Marshal(projectRef)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode private data, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, databaseCredentialsPrivateKey, privateRef)...)
+
+	role := httpResp.JSON201
+	user := role.Role
+	if endpoint.pooler {
+		user = poolerRoleUser(role.Role, projectRef, endpoint.user)
+	}
+
+	data.Role = types.StringValue(role.Role)
+	data.Password = types.StringValue(role.Password)
+	data.TtlSeconds = types.Int64Value(role.TtlSeconds)
+	data.ExpiresAt = types.StringValue(time.Now().Add(time.Duration(role.TtlSeconds) * time.Second).UTC().Format(time.RFC3339))
+	data.Host = types.StringValue(endpoint.host)
+	data.Port = types.Int64Value(int64(endpoint.port))
+	data.Database = types.StringValue(endpoint.database)
+	data.User = types.StringValue(user)
+	data.ConnectionString = types.StringValue(postgresUrl(url.UserPassword(user, role.Password), endpoint.host, endpoint.port, endpoint.database))
+
+	tflog.Trace(ctx, "opened ephemeral database credentials")
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *DatabaseCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	value, diags := req.Private.GetKey(ctx, databaseCredentialsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(value) == 0 {
+		return
+	}
+
+	var projectRef string
+	if err := json.Unmarshal(value, &projectRef); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to parse private data, got error: %s", err))
+		return
+	}
+
+	// The API offers no way to delete a single role, this revokes every temporary login role on the project
+	httpResp, err := e.client.V1DeleteLoginRolesWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to delete database credentials, got error: %s", err)
+		resp.Diagnostics.AddError("Client Error", msg)
+		return
+	}
+	if httpResp.JSON200 == nil && httpResp.StatusCode() != http.StatusNotFound {
+		msg := fmt.Sprintf("Unable to delete database credentials, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		resp.Diagnostics.AddError("Client Error", msg)
+		return
+	}
+
+	tflog.Trace(ctx, "closed ephemeral database credentials")
+}
+
+type databaseEndpoint struct {
+	host     string
+	port     int
+	database string
+	pooler   bool
+	// Pooler user name of the postgres role, as returned by the API.
+	user string
+}
+
+// poolerRoleUser returns the pooler user name of role. The shared pooler
+// identifies the tenant by the suffix of the postgres user it returns, which
+// applies to every role, while a dedicated pooler takes plain role names.
+func poolerRoleUser(role, projectRef, dbUser string) string {
+	if dbUser == "" {
+		return poolerUser(role, projectRef)
+	}
+	if _, tenant, ok := strings.Cut(dbUser, "."); ok {
+		return role + "." + tenant
+	}
+	return role
+}
+
+func getDatabaseEndpoint(ctx context.Context, client Client, projectRef, connectionType string) (databaseEndpoint, diag.Diagnostics) {
+	if connectionType == databaseConnectionDirect {
+		return databaseEndpoint{
+			host:     projectDbHost(projectRef),
+			port:     defaultDatabasePort,
+			database: defaultDatabaseName,
+		}, nil
+	}
+
+	httpResp, err := client.V1GetPoolerConfigWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read pooler, got error: %s", err)
+		return databaseEndpoint{}, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read pooler, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return databaseEndpoint{}, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	for _, pooler := range *httpResp.JSON200 {
+		if pooler.DatabaseType == api.SupavisorConfigResponseDatabaseTypePRIMARY {
+			return databaseEndpoint{
+				host:     pooler.DbHost,
+				port:     pooler.DbPort,
+				database: pooler.DbName,
+				pooler:   true,
+				user:     pooler.DbUser,
+			}, nil
+		}
+	}
+
+	msg := fmt.Sprintf("No pooler is configured for the primary database of project %s", projectRef)
+	return databaseEndpoint{}, diag.Diagnostics{diag.NewErrorDiagnostic("Pooler Not Found", msg)}
+}
@@ -0,0 +1,502 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oapi-codegen/nullable"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &DiskResource{}
+	_ resource.ResourceWithImportState    = &DiskResource{}
+	_ resource.ResourceWithValidateConfig = &DiskResource{}
+	_ resource.ResourceWithModifyPlan     = &DiskResource{}
+	_ resource.ResourceWithIdentity       = &DiskResource{}
+)
+
+// The platform rejects disk modifications made within this window of the previous one.
+const diskModificationCooldown = 6 * time.Hour
+
+// Limits for provisioned disk performance, per disk type.
+const (
+	gp3MinIops       = 3000
+	gp3MaxIops       = 16000
+	gp3MinThroughput = 125
+	gp3MaxThroughput = 1000
+	// gp3 allows up to 500 IOPS per GB and 0.25 MiB/s of throughput per IOPS.
+	gp3MaxIopsPerGb         = 500
+	gp3MaxIopsPerThroughput = 4
+	io2MinIops              = 100
+	io2MaxIops              = 80000
+	// io2 allows up to 1000 IOPS per GB.
+	io2MaxIopsPerGb = 1000
+)
+
+func NewDiskResource() resource.Resource {
+	return &DiskResource{}
+}
+
+// DiskResource defines the resource implementation.
+type DiskResource struct {
+	client Client
+}
+
+// DiskResourceModel describes the resource data model.
+type DiskResourceModel struct {
+	ProjectRef              types.String   `tfsdk:"project_ref"`
+	Type                    types.String   `tfsdk:"type"`
+	SizeGb                  types.Int64    `tfsdk:"size_gb"`
+	Iops                    types.Int64    `tfsdk:"iops"`
+	ThroughputMibps         types.Int64    `tfsdk:"throughput_mibps"`
+	AutoscaleGrowthPercent  types.Int64    `tfsdk:"autoscale_growth_percent"`
+	AutoscaleMaxSizeGb      types.Int64    `tfsdk:"autoscale_max_size_gb"`
+	AutoscaleMinIncrementGb types.Int64    `tfsdk:"autoscale_min_increment_gb"`
+	LastModifiedAt          types.String   `tfsdk:"last_modified_at"`
+	Id                      types.String   `tfsdk:"id"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DiskResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk"
+}
+
+func (r *DiskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Disk resource. Manages the type, size and provisioned performance of a project's database disk. " +
+			"Disks cannot shrink and the platform enforces a cooldown of 6 hours between modifications. " +
+			"Destroying this resource leaves the disk unchanged.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Disk type, either `gp3` or `io2`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.DiskRequestBodyAttributes0TypeGp3),
+						string(api.DiskRequestBodyAttributes1TypeIo2),
+					),
+				},
+			},
+			"size_gb": schema.Int64Attribute{
+				MarkdownDescription: "Disk size in GB. Disks can only grow.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(8),
+				},
+			},
+			"iops": schema.Int64Attribute{
+				MarkdownDescription: "Provisioned IOPS. Between 3000 and 16000 for `gp3`, or between 100 and 80000 for `io2`.",
+				Required:            true,
+			},
+			"throughput_mibps": schema.Int64Attribute{
+				MarkdownDescription: "Provisioned throughput in MiB/s, between 125 and 1000. Only supported by `gp3` disks.",
+				Optional:            true,
+			},
+			"autoscale_growth_percent": schema.Int64Attribute{
+				MarkdownDescription: "Percentage the disk grows by when autoscaling",
+				Computed:            true,
+			},
+			"autoscale_max_size_gb": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in GB the disk autoscales to",
+				Computed:            true,
+			},
+			"autoscale_min_increment_gb": schema.Int64Attribute{
+				MarkdownDescription: "Minimum increment in GB when autoscaling",
+				Computed:            true,
+			},
+			"last_modified_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the last disk modification",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Disk identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DiskResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DiskResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() || data.SizeGb.IsUnknown() || data.Iops.IsUnknown() || data.ThroughputMibps.IsUnknown() ||
+		data.Type.IsNull() || data.SizeGb.IsNull() || data.Iops.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDiskAttributes(&data)...)
+}
+
+// validateDiskAttributes checks the combination of disk type, size and performance.
+func validateDiskAttributes(data *DiskResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	iops := data.Iops.ValueInt64()
+	size := data.SizeGb.ValueInt64()
+	switch data.Type.ValueString() {
+	case string(api.DiskRequestBodyAttributes0TypeGp3):
+		if iops < gp3MinIops || iops > gp3MaxIops {
+			diags.AddAttributeError(path.Root("iops"), "Invalid Disk Configuration",
+				fmt.Sprintf("gp3 disks support between %d and %d IOPS, got: %d", gp3MinIops, gp3MaxIops, iops))
+		} else if iops > gp3MinIops && iops > size*gp3MaxIopsPerGb {
+			diags.AddAttributeError(path.Root("iops"), "Invalid Disk Configuration",
+				fmt.Sprintf("gp3 disks support at most %d IOPS per GB, a %d GB disk allows up to %d IOPS", gp3MaxIopsPerGb, size, size*gp3MaxIopsPerGb))
+		}
+		if data.ThroughputMibps.IsNull() {
+			break
+		}
+		throughput := data.ThroughputMibps.ValueInt64()
+		if throughput < gp3MinThroughput || throughput > gp3MaxThroughput {
+			diags.AddAttributeError(path.Root("throughput_mibps"), "Invalid Disk Configuration",
+				fmt.Sprintf("gp3 disks support between %d and %d MiB/s of throughput, got: %d", gp3MinThroughput, gp3MaxThroughput, throughput))
+		} else if throughput > gp3MinThroughput && throughput*gp3MaxIopsPerThroughput > iops {
+			diags.AddAttributeError(path.Root("throughput_mibps"), "Invalid Disk Configuration",
+				fmt.Sprintf("gp3 disks support at most 1 MiB/s of throughput per %d IOPS, %d IOPS allow up to %d MiB/s", gp3MaxIopsPerThroughput, iops, iops/gp3MaxIopsPerThroughput))
+		}
+	case string(api.DiskRequestBodyAttributes1TypeIo2):
+		if iops < io2MinIops || iops > io2MaxIops {
+			diags.AddAttributeError(path.Root("iops"), "Invalid Disk Configuration",
+				fmt.Sprintf("io2 disks support between %d and %d IOPS, got: %d", io2MinIops, io2MaxIops, iops))
+		} else if iops > size*io2MaxIopsPerGb {
+			diags.AddAttributeError(path.Root("iops"), "Invalid Disk Configuration",
+				fmt.Sprintf("io2 disks support at most %d IOPS per GB, a %d GB disk allows up to %d IOPS", io2MaxIopsPerGb, size, size*io2MaxIopsPerGb))
+		}
+		if !data.ThroughputMibps.IsNull() {
+			diags.AddAttributeError(path.Root("throughput_mibps"), "Invalid Disk Configuration",
+				"Throughput can only be configured on gp3 disks")
+		}
+	}
+
+	return diags
+}
+
+func (r *DiskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state DiskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ProjectRef.Equal(state.ProjectRef) || !diskAttributesChanged(&plan, &state) {
+		return
+	}
+
+	if !plan.SizeGb.IsUnknown() && plan.SizeGb.ValueInt64() < state.SizeGb.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("size_gb"), "Invalid Disk Configuration",
+			fmt.Sprintf("Disks cannot shrink, current size is %d GB", state.SizeGb.ValueInt64()))
+	}
+
+	if state.LastModifiedAt.IsNull() {
+		return
+	}
+	lastModified, err := time.Parse(time.RFC3339, state.LastModifiedAt.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("unable to parse disk last_modified_at: %s", err))
+		return
+	}
+	if next := lastModified.Add(diskModificationCooldown); time.Now().Before(next) {
+		resp.Diagnostics.AddError("Disk Modification Cooldown",
+			fmt.Sprintf("The disk of project %s was last modified at %s and cannot be modified again until %s",
+				state.ProjectRef.ValueString(), lastModified.Format(time.RFC3339), next.Format(time.RFC3339)))
+	}
+}
+
+func (r *DiskResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectRefIdentitySchema()
+}
+
+func (r *DiskResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *DiskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiskResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The disk already exists, only modify it when it differs from the plan
+	current := DiskResourceModel{ProjectRef: data.ProjectRef}
+	resp.Diagnostics.Append(readDisk(ctx, &current, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if diskAttributesChanged(&data, &current) {
+		resp.Diagnostics.Append(updateDisk(ctx, &data, r.client, createTimeout)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.Id = data.ProjectRef
+	resp.Diagnostics.Append(readDisk(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created disk")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *DiskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiskResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readDisk(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read disk")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *DiskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DiskResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diskAttributesChanged(&plan, &state) {
+		resp.Diagnostics.Append(updateDisk(ctx, &plan, r.client, updateTimeout)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(readDisk(ctx, &plan, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated disk")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(plan.ProjectRef))...)
+}
+
+func (r *DiskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiskResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Simply fallthrough since a project cannot exist without its disk.
+}
+
+func (r *DiskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectRef, diags := importProjectRef(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := DiskResourceModel{
+		ProjectRef: types.StringValue(projectRef),
+		Id:         types.StringValue(projectRef),
+		// Import the current throughput so it can be managed explicitly
+		ThroughputMibps: types.Int64Unknown(),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+			}),
+		},
+	}
+
+	resp.Diagnostics.Append(readDisk(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func diskAttributesChanged(plan, state *DiskResourceModel) bool {
+	return !plan.Type.Equal(state.Type) ||
+		!plan.SizeGb.Equal(state.SizeGb) ||
+		!plan.Iops.Equal(state.Iops) ||
+		// Throughput is optional, the platform default applies when unset
+		(!plan.ThroughputMibps.IsNull() && !plan.ThroughputMibps.Equal(state.ThroughputMibps))
+}
+
+func nullableToInt64(n nullable.Nullable[int]) types.Int64 {
+	if n.IsSpecified() && !n.IsNull() {
+		return types.Int64Value(int64(n.MustGet()))
+	}
+	return types.Int64Null()
+}
+
+func readDisk(ctx context.Context, data *DiskResourceModel, client Client) diag.Diagnostics {
+	projectRef := data.ProjectRef.ValueString()
+
+	httpResp, err := client.V1GetDatabaseDiskWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read disk, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read disk, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	// Both variants share their fields, io2 disks simply omit throughput
+	disk, err := httpResp.JSON200.Attributes.AsDiskResponseAttributes0()
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read disk attributes, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	data.Type = types.StringValue(string(disk.Type))
+	data.SizeGb = types.Int64Value(int64(disk.SizeGb))
+	data.Iops = types.Int64Value(int64(disk.Iops))
+	// Only track throughput when configured, the platform reports a default for gp3 disks
+	if disk.ThroughputMibps == nil {
+		data.ThroughputMibps = types.Int64Null()
+	} else if !data.ThroughputMibps.IsNull() {
+		data.ThroughputMibps = types.Int64Value(int64(*disk.ThroughputMibps))
+	}
+	data.LastModifiedAt = types.StringPointerValue(httpResp.JSON200.LastModifiedAt)
+
+	autoscaleResp, err := client.V1GetProjectDiskAutoscaleConfigWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read disk autoscale config, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if autoscaleResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read disk autoscale config, got status %d: %s", autoscaleResp.StatusCode(), autoscaleResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	data.AutoscaleGrowthPercent = nullableToInt64(autoscaleResp.JSON200.GrowthPercent)
+	data.AutoscaleMaxSizeGb = nullableToInt64(autoscaleResp.JSON200.MaxSizeGb)
+	data.AutoscaleMinIncrementGb = nullableToInt64(autoscaleResp.JSON200.MinIncrementGb)
+	return nil
+}
+
+func updateDisk(ctx context.Context, plan *DiskResourceModel, client Client, timeout time.Duration) diag.Diagnostics {
+	var attributes api.DiskRequestBody_Attributes
+	var err error
+	if plan.Type.ValueString() == string(api.DiskRequestBodyAttributes1TypeIo2) {
+		err = attributes.FromDiskRequestBodyAttributes1(api.DiskRequestBodyAttributes1{
+			Type:   api.DiskRequestBodyAttributes1TypeIo2,
+			SizeGb: int(plan.SizeGb.ValueInt64()),
+			Iops:   int(plan.Iops.ValueInt64()),
+		})
+	} else {
+		body := api.DiskRequestBodyAttributes0{
+			Type:   api.DiskRequestBodyAttributes0TypeGp3,
+			SizeGb: int(plan.SizeGb.ValueInt64()),
+			Iops:   int(plan.Iops.ValueInt64()),
+		}
+		if !plan.ThroughputMibps.IsNull() {
+			body.ThroughputMibps = Ptr(int(plan.ThroughputMibps.ValueInt64()))
+		}
+		err = attributes.FromDiskRequestBodyAttributes0(body)
+	}
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Internal Error",
+			fmt.Sprintf("Failed to configure disk attributes: %s", err),
+		)}
+	}
+
+	httpResp, err := client.V1ModifyDatabaseDiskWithResponse(ctx, plan.ProjectRef.ValueString(), api.V1ModifyDatabaseDiskJSONRequestBody{
+		Attributes: attributes,
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to modify disk, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusCreated {
+		msg := fmt.Sprintf("Unable to modify disk, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	// Wait for project to leave RESIZING after the disk is modified
+	return waitForProjectActive(ctx, plan.ProjectRef.ValueString(), client, timeout)
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func testDisk(size, iops, throughput int, lastModifiedAt string) map[string]any {
+	return map[string]any{
+		"attributes": map[string]any{
+			"type":             "gp3",
+			"size_gb":          size,
+			"iops":             iops,
+			"throughput_mibps": throughput,
+		},
+		"last_modified_at": lastModifiedAt,
+	}
+}
+
+var testDiskAutoscale = map[string]any{
+	"growth_percent":   50,
+	"max_size_gb":      200,
+	"min_increment_gb": 4,
+}
+
+func diskResourceConfig(size, iops int) string {
+	return fmt.Sprintf(`
+resource "supabase_disk" "production" {
+  project_ref = %q
+  type        = "gp3"
+  size_gb     = %d
+  iops        = %d
+}
+`, testProjectRef, size, iops)
+}
+
+func TestAccDiskResource(t *testing.T) {
+	defer gock.OffAll()
+	// Register nested paths first, gock matches paths as prefixes
+	gock.New(defaultApiEndpoint).
+		Get(diskAutoscaleApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testDiskAutoscale)
+	// Step 1: create
+	gock.New(defaultApiEndpoint).
+		Get(diskApiPath).
+		Reply(http.StatusOK).
+		JSON(testDisk(8, 3000, 125, "2024-01-01T00:00:00Z"))
+	gock.New(defaultApiEndpoint).
+		Post(diskApiPath).
+		AddMatcher(matchJSONBody(t, map[string]any{
+			"attributes": map[string]any{
+				"type":             "gp3",
+				"size_gb":          float64(100),
+				"iops":             float64(3000),
+				"throughput_mibps": float64(250),
+			},
+		})).
+		Reply(http.StatusCreated)
+	gock.New(defaultApiEndpoint).
+		Get(diskApiPath).
+		Times(5).
+		Reply(http.StatusOK).
+		JSON(testDisk(100, 3000, 250, "2024-01-01T00:00:00Z"))
+	// Step 3: update
+	gock.New(defaultApiEndpoint).
+		Post(diskApiPath).
+		Reply(http.StatusCreated)
+	gock.New(defaultApiEndpoint).
+		Get(diskApiPath).
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(testDisk(120, 3000, 250, "2024-01-01T00:00:00Z"))
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.DiskResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_disk.production", "id", testProjectRef),
+					resource.TestCheckResourceAttr("supabase_disk.production", "size_gb", "100"),
+					resource.TestCheckResourceAttr("supabase_disk.production", "throughput_mibps", "250"),
+					resource.TestCheckResourceAttr("supabase_disk.production", "autoscale_max_size_gb", "200"),
+					resource.TestCheckResourceAttr("supabase_disk.production", "last_modified_at", "2024-01-01T00:00:00Z"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_disk.production",
+				ImportState:       true,
+				ImportStateId:     testProjectRef,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+			},
+			// Update and Read testing
+			{
+				Config: diskResourceConfig(120, 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_disk.production", "size_gb", "120"),
+					resource.TestCheckNoResourceAttr("supabase_disk.production", "throughput_mibps"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDiskResource_InvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      diskResourceConfig(8, 6000),
+				ExpectError: regexp.MustCompile(`a 8 GB disk allows up to 4000 IOPS`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_disk" "production" {
+  project_ref      = %q
+  type             = "gp3"
+  size_gb          = 100
+  iops             = 3000
+  throughput_mibps = 1000
+}
+`, testProjectRef),
+				ExpectError: regexp.MustCompile(`up to 750 MiB/s`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_disk" "production" {
+  project_ref      = %q
+  type             = "io2"
+  size_gb          = 100
+  iops             = 5000
+  throughput_mibps = 250
+}
+`, testProjectRef),
+				ExpectError: regexp.MustCompile(`Throughput can only be configured on gp3 disks`),
+			},
+		},
+	})
+}
+
+func TestAccDiskResource_Cooldown(t *testing.T) {
+	defer gock.OffAll()
+	lastModifiedAt := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+	gock.New(defaultApiEndpoint).
+		Get(diskAutoscaleApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testDiskAutoscale)
+	gock.New(defaultApiEndpoint).
+		Get(diskApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testDisk(100, 3000, 125, lastModifiedAt))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Matching the current disk does not modify it
+			{
+				Config: diskResourceConfig(100, 3000),
+			},
+			{
+				Config:      diskResourceConfig(120, 3000),
+				ExpectError: regexp.MustCompile(`Disk Modification Cooldown`),
+			},
+			{
+				Config:      diskResourceConfig(50, 3000),
+				ExpectError: regexp.MustCompile(`Disks cannot shrink`),
+			},
+		},
+	})
+}
+
+func TestAccDiskResource_ImportIdentity(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(diskAutoscaleApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testDiskAutoscale)
+	gock.New(defaultApiEndpoint).
+		Get(diskApiPath).
+		Reply(http.StatusOK).
+		JSON(testDisk(8, 3000, 125, "2024-01-01T00:00:00Z"))
+	gock.New(defaultApiEndpoint).
+		Post(diskApiPath).
+		Reply(http.StatusCreated)
+	gock.New(defaultApiEndpoint).
+		Get(diskApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testDisk(100, 3000, 250, "2024-01-01T00:00:00Z"))
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: examples.DiskResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("supabase_disk.production", map[string]knownvalue.Check{
+						"project_ref": knownvalue.StringExact(testProjectRef),
+					}),
+				},
+			},
+			{
+				ResourceName:    "supabase_disk.production",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &EdgeFunctionListResource{}
+	_ list.ListResourceWithConfigure = &EdgeFunctionListResource{}
+)
+
+func NewEdgeFunctionListResource() list.ListResource {
+	return &EdgeFunctionListResource{}
+}
+
+// EdgeFunctionListResource defines the list resource implementation.
+type EdgeFunctionListResource struct {
+	client Client
+}
+
+// EdgeFunctionListResourceModel describes the list resource config model.
+type EdgeFunctionListResourceModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+}
+
+func (r *EdgeFunctionListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_function"
+}
+
+func (r *EdgeFunctionListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the edge functions deployed to a project",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *EdgeFunctionListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *EdgeFunctionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config EdgeFunctionListResourceModel
+
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	httpResp, err := r.client.V1ListAllFunctionsWithResponse(ctx, config.ProjectRef.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list edge functions, got error: %s", err)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list edge functions, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+
+	stream.Results = listResults(ctx, req, *httpResp.JSON200, func(function api.FunctionResponse, result *list.ListResult) {
+		data := EdgeFunctionResourceModel{
+			ProjectRef: config.ProjectRef,
+			Slug:       types.StringValue(function.Slug),
+		}
+		result.DisplayName = function.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, edgeFunctionIdentity(&data))...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		data.ProjectRef = config.ProjectRef
+		data.Slug = types.StringValue(function.Slug)
+		if _, diags := readEdgeFunction(ctx, &data, r.client); diags.HasError() {
+			result.Diagnostics.Append(diags...)
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccEdgeFunctionListResource(t *testing.T) {
+	defer gock.OffAll()
+
+	functionID := uuid.New().String()
+	gock.New(defaultApiEndpoint).
+		Get(functionsApiPath + "$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.FunctionResponse{
+			{Id: functionID, Slug: "hello-world", Name: "Hello World", Status: api.FunctionResponseStatusACTIVE, Version: 3},
+			{Id: uuid.New().String(), Slug: "stripe-webhook", Name: "Stripe Webhook", Status: api.FunctionResponseStatusACTIVE, Version: 1},
+		})
+	gock.New(defaultApiEndpoint).
+		Get(functionsApiPath + "/hello-world").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(api.FunctionSlugResponse{
+			Id:        functionID,
+			Slug:      "hello-world",
+			Name:      "Hello World",
+			Status:    api.FunctionSlugResponseStatusACTIVE,
+			Version:   3,
+			CreatedAt: 1700000000000,
+			UpdatedAt: 1700000000000,
+		})
+	gock.New(defaultApiEndpoint).
+		Get(functionsApiPath + "/stripe-webhook").
+		Persist().
+		Reply(http.StatusOK).
+		JSON(api.FunctionSlugResponse{
+			Id:      uuid.New().String(),
+			Slug:    "stripe-webhook",
+			Name:    "Stripe Webhook",
+			Status:  api.FunctionSlugResponseStatusACTIVE,
+			Version: 1,
+		})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Query: true,
+				Config: testAccQueryProviderConfig + examples.EdgeFunctionListResourceConfig + `
+list "supabase_edge_function" "first" {
+  provider = supabase
+  limit    = 1
+
+  config {
+    project_ref = "` + testProjectRef + `"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("supabase_edge_function.all", 2),
+					querycheck.ExpectIdentity("supabase_edge_function.all", map[string]knownvalue.Check{
+						"project_ref": knownvalue.StringExact(testProjectRef),
+						"slug":        knownvalue.StringExact("stripe-webhook"),
+					}),
+					querycheck.ExpectLength("supabase_edge_function.first", 1),
+					querycheck.ExpectResourceDisplayName("supabase_edge_function.first", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"project_ref": knownvalue.StringExact(testProjectRef),
+						"slug":        knownvalue.StringExact("hello-world"),
+					}), knownvalue.StringExact("Hello World")),
+					querycheck.ExpectResourceKnownValues("supabase_edge_function.all", queryfilter.ByDisplayName(knownvalue.StringExact("Hello World")), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("id"), KnownValue: knownvalue.StringExact(functionID)},
+						{Path: tfjsonpath.New("version"), KnownValue: knownvalue.Int64Exact(3)},
+					}),
+				},
+			},
+		},
+	})
+}
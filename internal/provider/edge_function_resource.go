@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -37,6 +38,7 @@ import (
 var (
 	_ resource.Resource                = &EdgeFunctionResource{}
 	_ resource.ResourceWithImportState = &EdgeFunctionResource{}
+	_ resource.ResourceWithIdentity    = &EdgeFunctionResource{}
 )
 
 // computes checksum from local files during plan phase
@@ -101,7 +103,7 @@ func NewEdgeFunctionResource() resource.Resource {
 }
 
 type EdgeFunctionResource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 type EdgeFunctionResourceModel struct {
@@ -121,6 +123,18 @@ type EdgeFunctionResourceModel struct {
 	UpdatedAt     types.Int64  `tfsdk:"updated_at"`
 }
 
+type EdgeFunctionResourceIdentityModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+	Slug       types.String `tfsdk:"slug"`
+}
+
+func edgeFunctionIdentity(data *EdgeFunctionResourceModel) EdgeFunctionResourceIdentityModel {
+	return EdgeFunctionResourceIdentityModel{
+		ProjectRef: data.ProjectRef,
+		Slug:       data.Slug,
+	}
+}
+
 type functionMetadata struct {
 	EntrypointPath string   `json:"entrypoint_path"`
 	Name           string   `json:"name"`
@@ -232,6 +246,21 @@ func (r *EdgeFunctionResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (r *EdgeFunctionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_ref": identityschema.StringAttribute{
+				Description:       "Project reference ID",
+				RequiredForImport: true,
+			},
+			"slug": identityschema.StringAttribute{
+				Description:       "Function slug",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *EdgeFunctionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
 		r.client = client
@@ -254,6 +283,7 @@ func (r *EdgeFunctionResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "create edge function")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, edgeFunctionIdentity(&data))...)
 }
 
 func (r *EdgeFunctionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -279,6 +309,7 @@ func (r *EdgeFunctionResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, edgeFunctionIdentity(&data))...)
 }
 
 func (r *EdgeFunctionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -297,6 +328,7 @@ func (r *EdgeFunctionResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "update edge function")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, edgeFunctionIdentity(&data))...)
 }
 
 func (r *EdgeFunctionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -316,23 +348,18 @@ func (r *EdgeFunctionResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *EdgeFunctionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, "/", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format 'project_ref/slug', got: %s", req.ID),
-		)
-		return
+	var projectRef, slug string
+	if req.ID != "" {
+		var diags diag.Diagnostics
+		projectRef, slug, diags = parseEdgeFunctionImportID(req.ID)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		var identity EdgeFunctionResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		projectRef, slug = identity.ProjectRef.ValueString(), identity.Slug.ValueString()
+		resp.Diagnostics.Append(validateEdgeFunctionSlug(slug)...)
 	}
-
-	projectRef := parts[0]
-	slug := parts[1]
-
-	if slug == "." || slug == ".." || slug != filepath.Base(slug) || strings.ContainsAny(slug, `/\`) {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Function slug contains path separators or traversal segments: %q", slug),
-		)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -399,9 +426,37 @@ func (r *EdgeFunctionResource) ImportState(ctx context.Context, req resource.Imp
 	data.LocalChecksum = types.StringNull()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, edgeFunctionIdentity(&data))...)
 }
 
-func deployEdgeFunction(ctx context.Context, data *EdgeFunctionResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+// parseEdgeFunctionImportID splits an import ID in the format 'project_ref/slug'.
+func parseEdgeFunctionImportID(id string) (projectRef, slug string, diags diag.Diagnostics) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'project_ref/slug', got: %s", id),
+		)
+		return "", "", diags
+	}
+
+	projectRef, slug = parts[0], parts[1]
+	diags.Append(validateEdgeFunctionSlug(slug)...)
+	return projectRef, slug, diags
+}
+
+// validateEdgeFunctionSlug rejects slugs that would escape the functions directory on download.
+func validateEdgeFunctionSlug(slug string) diag.Diagnostics {
+	if slug == "." || slug == ".." || slug != filepath.Base(slug) || strings.ContainsAny(slug, `/\`) {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Invalid Import ID",
+			fmt.Sprintf("Function slug contains path separators or traversal segments: %q", slug),
+		)}
+	}
+	return nil
+}
+
+func deployEdgeFunction(ctx context.Context, data *EdgeFunctionResourceModel, client Client) diag.Diagnostics {
 	projectRef := data.ProjectRef.ValueString()
 	slug := data.Slug.ValueString()
 	entrypoint := data.Entrypoint.ValueString()
@@ -568,7 +623,7 @@ func deployEdgeFunction(ctx context.Context, data *EdgeFunctionResourceModel, cl
 	return nil
 }
 
-func readEdgeFunction(ctx context.Context, data *EdgeFunctionResourceModel, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+func readEdgeFunction(ctx context.Context, data *EdgeFunctionResourceModel, client Client) (bool, diag.Diagnostics) {
 	projectRef := data.ProjectRef.ValueString()
 	slug := data.Slug.ValueString()
 
@@ -604,7 +659,7 @@ func readEdgeFunction(ctx context.Context, data *EdgeFunctionResourceModel, clie
 	return true, nil
 }
 
-func deleteEdgeFunction(ctx context.Context, data *EdgeFunctionResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func deleteEdgeFunction(ctx context.Context, data *EdgeFunctionResourceModel, client Client) diag.Diagnostics {
 	projectRef := data.ProjectRef.ValueString()
 	slug := data.Slug.ValueString()
 
@@ -627,11 +682,11 @@ func deleteEdgeFunction(ctx context.Context, data *EdgeFunctionResourceModel, cl
 	return nil
 }
 
-func downloadFunctionSource(ctx context.Context, data *EdgeFunctionResourceModel, outputDir string, client *api.ClientWithResponses, apiEntrypointPath, apiImportMapPath *string) diag.Diagnostics {
+func downloadFunctionSource(ctx context.Context, data *EdgeFunctionResourceModel, outputDir string, client Client, apiEntrypointPath, apiImportMapPath *string) diag.Diagnostics {
 	projectRef := data.ProjectRef.ValueString()
 	slug := data.Slug.ValueString()
 
-	httpResp, err := client.V1GetAFunctionBody(ctx, projectRef, slug, func(ctx context.Context, req *http.Request) error {
+	httpResp, err := client.V1GetAFunctionBodyWithResponse(ctx, projectRef, slug, func(ctx context.Context, req *http.Request) error {
 		req.Header.Set("Accept", "multipart/form-data")
 		return nil
 	})
@@ -639,15 +694,14 @@ func downloadFunctionSource(ctx context.Context, data *EdgeFunctionResourceModel
 		msg := fmt.Sprintf("Unable to download function body, got error: %s", err)
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
 	}
-	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(io.LimitReader(httpResp.Body, 4096))
-		msg := fmt.Sprintf("Unable to download function body, got status %d: %s", httpResp.StatusCode, string(body))
+	if httpResp.StatusCode() != http.StatusOK {
+		body := httpResp.Body[:min(len(httpResp.Body), 4096)]
+		msg := fmt.Sprintf("Unable to download function body, got status %d: %s", httpResp.StatusCode(), string(body))
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
 	}
 
-	mediaType, params, err := mime.ParseMediaType(httpResp.Header.Get("Content-Type"))
+	mediaType, params, err := mime.ParseMediaType(httpResp.HTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		msg := fmt.Sprintf("Unable to parse Content-Type, got error: %s", err)
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
@@ -657,7 +711,7 @@ func downloadFunctionSource(ctx context.Context, data *EdgeFunctionResourceModel
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
 	}
 
-	mr := multipart.NewReader(httpResp.Body, params["boundary"])
+	mr := multipart.NewReader(bytes.NewReader(httpResp.Body), params["boundary"])
 	form, err := mr.ReadForm(10 << 20)
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read multipart form, got error: %s", err)
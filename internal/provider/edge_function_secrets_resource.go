@@ -24,6 +24,7 @@ import (
 var (
 	_ resource.Resource                = &EdgeFunctionSecretsResource{}
 	_ resource.ResourceWithImportState = &EdgeFunctionSecretsResource{}
+	_ resource.ResourceWithIdentity    = &EdgeFunctionSecretsResource{}
 )
 
 const supabasePrefix = "SUPABASE_"
@@ -92,7 +93,7 @@ func NewEdgeFunctionSecretsResource() resource.Resource {
 }
 
 type EdgeFunctionSecretsResource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 type SecretModel struct {
@@ -165,6 +166,10 @@ func (r *EdgeFunctionSecretsResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *EdgeFunctionSecretsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectRefIdentitySchema()
+}
+
 func (r *EdgeFunctionSecretsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
 		r.client = client
@@ -191,6 +196,7 @@ func (r *EdgeFunctionSecretsResource) Create(ctx context.Context, req resource.C
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
 }
 
 func (r *EdgeFunctionSecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -220,6 +226,7 @@ func (r *EdgeFunctionSecretsResource) Read(ctx context.Context, req resource.Rea
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
 }
 
 func (r *EdgeFunctionSecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -281,6 +288,7 @@ func (r *EdgeFunctionSecretsResource) Update(ctx context.Context, req resource.U
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(plan.ProjectRef))...)
 }
 
 func (r *EdgeFunctionSecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -317,13 +325,18 @@ func (r *EdgeFunctionSecretsResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *EdgeFunctionSecretsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectRef, diags := importProjectRef(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.AddWarning(
 		"Secret Values Not Returned",
 		"The Supabase management API only returns SHA-256 hashes of secret values. "+
 			"After import, Terraform will show a plan to update these secrets "+
 			"to match the values defined in your configuration.",
 	)
-	projectRef := req.ID
 
 	var data EdgeFunctionSecretsResourceModel
 	data.ProjectRef = types.StringValue(projectRef)
@@ -345,6 +358,7 @@ func (r *EdgeFunctionSecretsResource) ImportState(ctx context.Context, req resou
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
 }
 
 // computeSecretDigest returns the hex-encoded SHA-256 digest of the given string value.
@@ -390,7 +404,7 @@ func buildSecretSetAndDigestMap(ctx context.Context, secretModels []SecretModel,
 	return secretSet, newSecretDigests, nil
 }
 
-func createOrUpdateEdgeFunctionSecrets(ctx context.Context, data *EdgeFunctionSecretsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func createOrUpdateEdgeFunctionSecrets(ctx context.Context, data *EdgeFunctionSecretsResourceModel, client Client) diag.Diagnostics {
 	projectRef := data.ProjectRef.ValueString()
 
 	// Parse secretModels from the model
@@ -441,7 +455,7 @@ func createOrUpdateEdgeFunctionSecrets(ctx context.Context, data *EdgeFunctionSe
 // Returns (secrets, diagnostics) where:
 // - secrets is the list from the API (nil if error or not found)
 // - diagnostics contains any errors encountered.
-func fetchEdgeFunctionSecrets(ctx context.Context, projectRef string, client *api.ClientWithResponses) (*[]api.SecretResponse, diag.Diagnostics) {
+func fetchEdgeFunctionSecrets(ctx context.Context, projectRef string, client Client) (*[]api.SecretResponse, diag.Diagnostics) {
 	httpResp, err := client.V1ListAllSecretsWithResponse(ctx, projectRef)
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read edge function secrets, got error: %s", err)
@@ -463,7 +477,7 @@ func fetchEdgeFunctionSecrets(ctx context.Context, projectRef string, client *ap
 
 // readEdgeFunctionSecretsForImport populates state from ALL non-SUPABASE_ secrets returned by the API.
 // Returns (true, nil) if secrets are found, (false, nil) if not found, or (false, diags) on error.
-func readEdgeFunctionSecretsForImport(ctx context.Context, data *EdgeFunctionSecretsResourceModel, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+func readEdgeFunctionSecretsForImport(ctx context.Context, data *EdgeFunctionSecretsResourceModel, client Client) (bool, diag.Diagnostics) {
 	projectRef := data.ProjectRef.ValueString()
 
 	apiSecrets, diags := fetchEdgeFunctionSecrets(ctx, projectRef, client)
@@ -503,7 +517,7 @@ func readEdgeFunctionSecretsForImport(ctx context.Context, data *EdgeFunctionSec
 // readEdgeFunctionSecretsForRead reconciles only the secrets already present in Terraform state (from prior reads/applies).
 // This prevents absorbing unmanaged secrets into state.
 // Returns (true, nil) if secrets are found, (false, nil) if not found, or (false, diags) on error.
-func readEdgeFunctionSecretsForRead(ctx context.Context, data *EdgeFunctionSecretsResourceModel, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+func readEdgeFunctionSecretsForRead(ctx context.Context, data *EdgeFunctionSecretsResourceModel, client Client) (bool, diag.Diagnostics) {
 	projectRef := data.ProjectRef.ValueString()
 
 	apiSecrets, diags := fetchEdgeFunctionSecrets(ctx, projectRef, client)
@@ -587,7 +601,7 @@ func readEdgeFunctionSecretsForRead(ctx context.Context, data *EdgeFunctionSecre
 	return true, nil
 }
 
-func deleteEdgeFunctionSecrets(ctx context.Context, projectRef string, secretToDelete []string, client *api.ClientWithResponses) diag.Diagnostics {
+func deleteEdgeFunctionSecrets(ctx context.Context, projectRef string, secretToDelete []string, client Client) diag.Diagnostics {
 	if len(secretToDelete) == 0 {
 		// Nothing to delete
 		return nil
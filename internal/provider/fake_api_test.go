@@ -0,0 +1,1459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/oapi-codegen/nullable"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// fakeManagementAPI is a stateful, in-memory Management API served over
+// httptest. Acceptance tests point the provider at it through
+// SUPABASE_API_ENDPOINT, so whole plan, apply and import lifecycles run
+// against realistic responses instead of a script of gock exchanges.
+//
+// Projects and branches report transient statuses such as COMING_UP for one
+// read before settling, which exercises the provider's polling.
+type fakeManagementAPI struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	projects map[string]*fakeProject
+	branches map[string]*fakeBranch
+	order    []string
+	nextRef  int
+}
+
+// fakeProject holds a project and everything scoped to it. Branches are backed
+// by a fakeProject too, since they have their own ref, keys and configs.
+type fakeProject struct {
+	id                   int
+	project              api.V1ProjectWithDatabaseResponse
+	pending              []api.V1ProjectWithDatabaseResponseStatus
+	isBranch             bool
+	password             string
+	instanceSize         string
+	addons               map[string]string
+	legacyApiKeysEnabled bool
+	apiKeys              []api.ApiKeyResponse
+	secrets              map[string]api.SecretResponse
+	functions            map[string]*fakeFunction
+	thirdPartyAuth       []api.ThirdPartyAuth
+	configs              map[string]map[string]any
+	allowedCidrs         []string
+	allowedCidrsV6       []string
+	sslEnforcement       bool
+}
+
+type fakeBranch struct {
+	branch api.BranchResponse
+}
+
+type fakeFunction struct {
+	function api.FunctionResponse
+	files    map[string]string
+}
+
+const (
+	fakeAccessToken        = "test"
+	fakeConfigPostgres     = "postgres"
+	fakeConfigPostgrest    = "postgrest"
+	fakeConfigAuth         = "auth"
+	fakeConfigStorage      = "storage"
+	fakeFunctionSourcePath = "/src/"
+)
+
+// newFakeManagementAPI starts a fake Management API that is shut down when the
+// test ends.
+func newFakeManagementAPI(t *testing.T) *fakeManagementAPI {
+	t.Helper()
+	f := &fakeManagementAPI{
+		projects: map[string]*fakeProject{},
+		branches: map[string]*fakeBranch{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/projects", f.listProjects)
+	mux.HandleFunc("POST /v1/projects", f.createProject)
+	mux.HandleFunc("GET /v1/projects/{ref}", f.getProject)
+	mux.HandleFunc("PATCH /v1/projects/{ref}", f.updateProject)
+	mux.HandleFunc("DELETE /v1/projects/{ref}", f.deleteProject)
+	mux.HandleFunc("POST /v1/projects/{ref}/pause", f.pauseProject)
+	mux.HandleFunc("POST /v1/projects/{ref}/restore", f.restoreProject)
+	mux.HandleFunc("GET /v1/projects/{ref}/health", f.getHealth)
+	mux.HandleFunc("PATCH /v1/projects/{ref}/database/password", f.updatePassword)
+	mux.HandleFunc("GET /v1/projects/{ref}/billing/addons", f.listAddons)
+	mux.HandleFunc("PATCH /v1/projects/{ref}/billing/addons", f.applyAddon)
+	mux.HandleFunc("DELETE /v1/projects/{ref}/billing/addons/{variant}", f.removeAddon)
+	mux.HandleFunc("GET /v1/projects/{ref}/config/database/pooler", f.getPooler)
+
+	mux.HandleFunc("GET /v1/projects/{ref}/api-keys", f.listApiKeys)
+	mux.HandleFunc("POST /v1/projects/{ref}/api-keys", f.createApiKey)
+	mux.HandleFunc("GET /v1/projects/{ref}/api-keys/legacy", f.getLegacyApiKeys)
+	mux.HandleFunc("PUT /v1/projects/{ref}/api-keys/legacy", f.updateLegacyApiKeys)
+	mux.HandleFunc("GET /v1/projects/{ref}/api-keys/{id}", f.getApiKey)
+	mux.HandleFunc("PATCH /v1/projects/{ref}/api-keys/{id}", f.updateApiKey)
+	mux.HandleFunc("DELETE /v1/projects/{ref}/api-keys/{id}", f.deleteApiKey)
+
+	mux.HandleFunc("GET /v1/projects/{ref}/secrets", f.listSecrets)
+	mux.HandleFunc("POST /v1/projects/{ref}/secrets", f.createSecrets)
+	mux.HandleFunc("DELETE /v1/projects/{ref}/secrets", f.deleteSecrets)
+
+	mux.HandleFunc("GET /v1/projects/{ref}/functions", f.listFunctions)
+	mux.HandleFunc("POST /v1/projects/{ref}/functions/deploy", f.deployFunction)
+	mux.HandleFunc("GET /v1/projects/{ref}/functions/{slug}", f.getFunction)
+	mux.HandleFunc("GET /v1/projects/{ref}/functions/{slug}/body", f.getFunctionBody)
+	mux.HandleFunc("DELETE /v1/projects/{ref}/functions/{slug}", f.deleteFunction)
+
+	mux.HandleFunc("GET /v1/projects/{ref}/branches", f.listBranches)
+	mux.HandleFunc("POST /v1/projects/{ref}/branches", f.createBranch)
+	mux.HandleFunc("GET /v1/branches/{id}", f.getBranch)
+	mux.HandleFunc("PATCH /v1/branches/{id}", f.updateBranch)
+	mux.HandleFunc("DELETE /v1/branches/{id}", f.deleteBranch)
+
+	mux.HandleFunc("GET /v1/projects/{ref}/config/auth/third-party-auth", f.listThirdPartyAuth)
+	mux.HandleFunc("POST /v1/projects/{ref}/config/auth/third-party-auth", f.createThirdPartyAuth)
+	mux.HandleFunc("GET /v1/projects/{ref}/config/auth/third-party-auth/{id}", f.getThirdPartyAuth)
+	mux.HandleFunc("DELETE /v1/projects/{ref}/config/auth/third-party-auth/{id}", f.deleteThirdPartyAuth)
+
+	mux.HandleFunc("GET /v1/projects/{ref}/config/database/postgres", f.getConfig(fakeConfigPostgres))
+	mux.HandleFunc("PUT /v1/projects/{ref}/config/database/postgres", f.updateConfig(fakeConfigPostgres))
+	mux.HandleFunc("GET /v1/projects/{ref}/postgrest", f.getConfig(fakeConfigPostgrest))
+	mux.HandleFunc("PATCH /v1/projects/{ref}/postgrest", f.updateConfig(fakeConfigPostgrest))
+	mux.HandleFunc("GET /v1/projects/{ref}/config/auth", f.getConfig(fakeConfigAuth))
+	mux.HandleFunc("PATCH /v1/projects/{ref}/config/auth", f.updateConfig(fakeConfigAuth))
+	mux.HandleFunc("GET /v1/projects/{ref}/config/storage", f.getConfig(fakeConfigStorage))
+	mux.HandleFunc("PATCH /v1/projects/{ref}/config/storage", f.updateConfig(fakeConfigStorage))
+	mux.HandleFunc("GET /v1/projects/{ref}/network-restrictions", f.getNetworkRestrictions)
+	mux.HandleFunc("POST /v1/projects/{ref}/network-restrictions/apply", f.updateNetworkRestrictions)
+	mux.HandleFunc("GET /v1/projects/{ref}/ssl-enforcement", f.getSslEnforcement)
+	mux.HandleFunc("PUT /v1/projects/{ref}/ssl-enforcement", f.updateSslEnforcement)
+
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+fakeAccessToken {
+			writeFakeError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		// Requests are served one at a time so handlers need no locking
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(f.server.Close)
+
+	return f
+}
+
+// preCheck configures the provider to use the fake API.
+func (f *fakeManagementAPI) preCheck(t *testing.T) {
+	testAccPreCheck(t)
+	t.Setenv("SUPABASE_ACCESS_TOKEN", fakeAccessToken)
+	t.Setenv("SUPABASE_API_ENDPOINT", f.server.URL)
+}
+
+// providerFactories use the server's own client, so requests to the fake are
+// never intercepted by gock.
+func (f *fakeManagementAPI) providerFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"supabase": providerserver.NewProtocol6WithError(newWithBaseClient("test", f.server.Client())()),
+	}
+}
+
+// client returns an API client for unit tests that call provider helpers
+// directly.
+func (f *fakeManagementAPI) client(t *testing.T) Client {
+	t.Helper()
+	client, err := newApiClient(f.server.URL, fakeAccessToken, "test", f.server.Client())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+// addProject seeds a healthy project and returns its ref.
+func (f *fakeManagementAPI) addProject(name string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p := f.newProject(name, "continued-brown-smelt", "us-east-1", false)
+	return p.project.Id
+}
+
+// addFunction seeds a deployed function whose files are keyed by their bundle
+// path, such as /src/index.ts.
+func (f *fakeManagementAPI) addFunction(ref, slug, entrypoint string, files map[string]string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := time.Now().UnixMilli()
+	f.projects[ref].functions[slug] = &fakeFunction{
+		function: api.FunctionResponse{
+			Id:             uuid.NewString(),
+			Slug:           slug,
+			Name:           slug,
+			Status:         api.FunctionResponseStatusACTIVE,
+			Version:        1,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+			EntrypointPath: Ptr("file://" + entrypoint),
+			EzbrSha256:     Ptr(fakeBundleChecksum(files)),
+			VerifyJwt:      Ptr(true),
+		},
+		files: files,
+	}
+}
+
+// projectStatus returns the status a project would report next, or an empty
+// string if it does not exist.
+func (f *fakeManagementAPI) projectStatus(ref string) api.V1ProjectWithDatabaseResponseStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if p, ok := f.projects[ref]; ok {
+		return p.project.Status
+	}
+	return ""
+}
+
+// projectPassword returns the current database password of a project.
+func (f *fakeManagementAPI) projectPassword(ref string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if p, ok := f.projects[ref]; ok {
+		return p.password
+	}
+	return ""
+}
+
+// newProject creates an active project with the defaults of a new Supabase
+// project: legacy keys, reserved secrets and default service configs.
+func (f *fakeManagementAPI) newProject(name, organization, region string, isBranch bool) *fakeProject {
+	f.nextRef++
+	ref := fakeProjectRef(f.nextRef)
+
+	p := &fakeProject{
+		id:                   f.nextRef,
+		isBranch:             isBranch,
+		legacyApiKeysEnabled: true,
+		secrets:              map[string]api.SecretResponse{},
+		functions:            map[string]*fakeFunction{},
+		configs: map[string]map[string]any{
+			fakeConfigPostgres: {},
+			fakeConfigPostgrest: {
+				"db_schema":            "public,graphql_public",
+				"db_extra_search_path": "public,extensions",
+				"max_rows":             1000,
+				"db_pool":              nil,
+			},
+			fakeConfigAuth: {
+				"site_url":                              "http://localhost:3000",
+				"jwt_exp":                               3600,
+				"disable_signup":                        false,
+				"external_email_enabled":                true,
+				"mailer_autoconfirm":                    false,
+				"password_min_length":                   6,
+				"security_refresh_token_reuse_interval": 10,
+				// The API always returns the admin email, as null when unset
+				"smtp_admin_email": nil,
+			},
+			fakeConfigStorage: {
+				"fileSizeLimit": 52428800,
+				"features": map[string]any{
+					"imageTransformation": map[string]any{"enabled": true},
+					"s3Protocol":          map[string]any{"enabled": true},
+				},
+			},
+		},
+		allowedCidrs:   []string{"0.0.0.0/0"},
+		allowedCidrsV6: []string{"::/0"},
+	}
+	p.project = api.V1ProjectWithDatabaseResponse{
+		Id:               ref,
+		Ref:              ref,
+		Name:             name,
+		OrganizationId:   organization,
+		OrganizationSlug: organization,
+		Region:           region,
+		Status:           api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY,
+		CreatedAt:        time.Now().UTC().Format(time.RFC3339),
+	}
+	p.project.Database.Host = projectDbHost(ref)
+	p.project.Database.Version = "17.4.1.054"
+	p.project.Database.PostgresEngine = "17"
+	p.project.Database.ReleaseChannel = "ga"
+
+	for _, name := range []string{"anon", "service_role"} {
+		p.apiKeys = append(p.apiKeys, api.ApiKeyResponse{
+			Name:   name,
+			Id:     nullable.NewNullableWithValue(name),
+			ApiKey: nullable.NewNullableWithValue("eyJ." + name + "." + ref),
+			Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypeLegacy),
+		})
+	}
+	for _, name := range []string{"SUPABASE_URL", "SUPABASE_ANON_KEY", "SUPABASE_SERVICE_ROLE_KEY", "SUPABASE_DB_URL"} {
+		p.secrets[name] = api.SecretResponse{Name: name, Value: computeSecretDigest(name)}
+	}
+
+	f.projects[ref] = p
+	f.order = append(f.order, ref)
+	return p
+}
+
+// fakeProjectRef returns a 20 letter ref that is unique for n.
+func fakeProjectRef(n int) string {
+	ref := []byte(strings.Repeat("a", 20))
+	for i := len(ref) - 1; n > 0; i-- {
+		ref[i] = byte('a' + n%26)
+		n /= 26
+	}
+	return string(ref)
+}
+
+// transition reports status on the next read and settles on then afterwards.
+func (p *fakeProject) transition(status api.V1ProjectWithDatabaseResponseStatus, then ...api.V1ProjectWithDatabaseResponseStatus) {
+	p.project.Status = status
+	p.pending = then
+}
+
+// advance moves a project to its next pending status after it was read.
+func (p *fakeProject) advance() {
+	if len(p.pending) > 0 {
+		p.project.Status = p.pending[0]
+		p.pending = p.pending[1:]
+	}
+}
+
+// lookup finds the project or branch in the path, writing a 404 if missing.
+func (f *fakeManagementAPI) lookup(w http.ResponseWriter, r *http.Request) (*fakeProject, bool) {
+	p, ok := f.projects[r.PathValue("ref")]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, "Project not found")
+	}
+	return p, ok
+}
+
+// lookupProject is like lookup, but branches are not served by the projects
+// endpoint itself.
+func (f *fakeManagementAPI) lookupProject(w http.ResponseWriter, r *http.Request) (*fakeProject, bool) {
+	p, ok := f.projects[r.PathValue("ref")]
+	if !ok || p.isBranch {
+		writeFakeError(w, http.StatusNotFound, "Project not found")
+		return nil, false
+	}
+	return p, true
+}
+
+func (f *fakeManagementAPI) listProjects(w http.ResponseWriter, r *http.Request) {
+	result := []api.V1ProjectWithDatabaseResponse{}
+	for _, ref := range f.order {
+		if p, ok := f.projects[ref]; ok && !p.isBranch {
+			result = append(result, p.project)
+		}
+	}
+	writeFakeJSON(w, http.StatusOK, result)
+}
+
+func (f *fakeManagementAPI) createProject(w http.ResponseWriter, r *http.Request) {
+	var body api.V1CreateProjectBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	if body.Name == "" || body.OrganizationSlug == "" || body.DbPass == "" {
+		writeFakeError(w, http.StatusBadRequest, "name, organization_slug and db_pass are required")
+		return
+	}
+
+	region := "us-east-1"
+	if body.RegionSelection != nil {
+		if selection, err := body.RegionSelection.AsV1CreateProjectBodyRegionSelection0(); err == nil && selection.Code != "" {
+			region = string(selection.Code)
+		}
+	} else if body.Region != nil {
+		region = string(*body.Region)
+	}
+
+	p := f.newProject(body.Name, body.OrganizationSlug, region, false)
+	p.password = body.DbPass
+	if body.DesiredInstanceSize != nil {
+		p.instanceSize = string(*body.DesiredInstanceSize)
+	}
+	p.transition(api.V1ProjectWithDatabaseResponseStatusCOMINGUP, api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY)
+
+	writeFakeJSON(w, http.StatusCreated, api.V1ProjectResponse{
+		Id:               p.project.Id,
+		Ref:              p.project.Ref,
+		Name:             p.project.Name,
+		OrganizationId:   p.project.OrganizationId,
+		OrganizationSlug: p.project.OrganizationSlug,
+		Region:           p.project.Region,
+		Status:           api.V1ProjectResponseStatus(p.project.Status),
+		CreatedAt:        p.project.CreatedAt,
+	})
+}
+
+func (f *fakeManagementAPI) getProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookupProject(w, r)
+	if !ok {
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, p.project)
+	p.advance()
+}
+
+func (f *fakeManagementAPI) updateProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookupProject(w, r)
+	if !ok {
+		return
+	}
+	var body api.V1UpdateProjectBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	p.project.Name = body.Name
+	writeFakeJSON(w, http.StatusOK, api.V1ProjectRefResponse{Id: p.id, Ref: p.project.Ref, Name: p.project.Name})
+}
+
+func (f *fakeManagementAPI) deleteProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookupProject(w, r)
+	if !ok {
+		return
+	}
+	for id, b := range f.branches {
+		if b.branch.ParentProjectRef == p.project.Ref {
+			delete(f.projects, b.branch.ProjectRef)
+			delete(f.branches, id)
+		}
+	}
+	delete(f.projects, p.project.Ref)
+	writeFakeJSON(w, http.StatusOK, api.V1ProjectRefResponse{Id: p.id, Ref: p.project.Ref, Name: p.project.Name})
+}
+
+func (f *fakeManagementAPI) pauseProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookupProject(w, r)
+	if !ok {
+		return
+	}
+	if p.project.Status != api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY {
+		writeFakeError(w, http.StatusBadRequest, "Project is not active")
+		return
+	}
+	p.transition(api.V1ProjectWithDatabaseResponseStatusPAUSING, api.V1ProjectWithDatabaseResponseStatusINACTIVE)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (f *fakeManagementAPI) restoreProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookupProject(w, r)
+	if !ok {
+		return
+	}
+	if p.project.Status != api.V1ProjectWithDatabaseResponseStatusINACTIVE {
+		writeFakeError(w, http.StatusBadRequest, "Project is not paused")
+		return
+	}
+	p.transition(api.V1ProjectWithDatabaseResponseStatusRESTORING, api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (f *fakeManagementAPI) getHealth(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	status := api.ACTIVEHEALTHY
+	if p.project.Status != api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY {
+		status = api.COMINGUP
+	}
+	result := []api.V1ServiceHealthResponse{}
+	for _, service := range r.URL.Query()["services"] {
+		result = append(result, api.V1ServiceHealthResponse{
+			Name:    api.V1ServiceHealthResponseName(service),
+			Healthy: status == api.ACTIVEHEALTHY,
+			Status:  status,
+		})
+	}
+	writeFakeJSON(w, http.StatusOK, result)
+}
+
+func (f *fakeManagementAPI) updatePassword(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	var body api.V1UpdatePasswordBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	p.password = body.Password
+	writeFakeJSON(w, http.StatusOK, map[string]any{"message": "ok"})
+}
+
+// fakeAddonVariants lists the variants of the add-ons other than compute that
+// projects can select, keyed by add-on type.
+var fakeAddonVariants = map[string][]string{
+	"ipv4": {"ipv4_default"},
+	"pitr": {"pitr_7", "pitr_14", "pitr_28"},
+}
+
+// fakeAddonVariantName mirrors the display names of the real API, such as
+// "7 days" for pitr_7.
+func fakeAddonVariantName(variant string) string {
+	if days, ok := strings.CutPrefix(variant, "pitr_"); ok {
+		return days + " days"
+	}
+	return "Dedicated IPv4 address"
+}
+
+func fakeAddonVariant(id, name string) map[string]any {
+	return map[string]any{"id": id, "name": name, "price": map[string]any{}}
+}
+
+func (f *fakeManagementAPI) listAddons(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	selected := []map[string]any{}
+	if p.instanceSize != "" {
+		selected = append(selected, map[string]any{
+			"type":    api.ListProjectAddonsResponseSelectedAddonsTypeComputeInstance,
+			"variant": fakeAddonVariant("ci_"+p.instanceSize, p.instanceSize),
+		})
+	}
+	available := []map[string]any{}
+	for _, addonType := range slices.Sorted(maps.Keys(fakeAddonVariants)) {
+		variants := []map[string]any{}
+		for _, variant := range fakeAddonVariants[addonType] {
+			variants = append(variants, fakeAddonVariant(variant, fakeAddonVariantName(variant)))
+		}
+		available = append(available, map[string]any{"type": addonType, "name": addonType, "variants": variants})
+		if variant, ok := p.addons[addonType]; ok {
+			selected = append(selected, map[string]any{
+				"type":    addonType,
+				"variant": fakeAddonVariant(variant, fakeAddonVariantName(variant)),
+			})
+		}
+	}
+	writeFakeJSON(w, http.StatusOK, map[string]any{
+		"selected_addons":  selected,
+		"available_addons": available,
+	})
+}
+
+func (f *fakeManagementAPI) applyAddon(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	var body api.ApplyProjectAddonBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	variant, err := addonVariantId(body.AddonVariant)
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "Invalid addon variant")
+		return
+	}
+	if body.AddonType == api.ApplyProjectAddonBodyAddonTypeComputeInstance {
+		p.instanceSize = strings.TrimPrefix(variant, "ci_")
+		p.transition(api.V1ProjectWithDatabaseResponseStatusRESIZING, api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if !slices.Contains(fakeAddonVariants[string(body.AddonType)], variant) {
+		writeFakeError(w, http.StatusBadRequest, fmt.Sprintf("Variant %s is not available for addon %s", variant, body.AddonType))
+		return
+	}
+	if p.addons == nil {
+		p.addons = map[string]string{}
+	}
+	p.addons[string(body.AddonType)] = variant
+	w.WriteHeader(http.StatusOK)
+}
+
+func (f *fakeManagementAPI) removeAddon(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	variant := r.PathValue("variant")
+	for addonType, selected := range p.addons {
+		if selected == variant {
+			delete(p.addons, addonType)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	writeFakeError(w, http.StatusNotFound, "Addon not found")
+}
+
+// projectAddons returns the selected add-on variants of a project other than
+// compute, keyed by type.
+func (f *fakeManagementAPI) projectAddons(ref string) map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if p, ok := f.projects[ref]; ok {
+		return maps.Clone(p.addons)
+	}
+	return nil
+}
+
+func (f *fakeManagementAPI) getPooler(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	host := fmt.Sprintf("aws-0-%s.pooler.supabase.com", p.project.Region)
+	writeFakeJSON(w, http.StatusOK, []api.SupavisorConfigResponse{{
+		ConnectionString: fmt.Sprintf("postgresql://%s@%s:6543/postgres", poolerUser("postgres", p.project.Ref), host),
+		DatabaseType:     api.SupavisorConfigResponseDatabaseTypePRIMARY,
+		DbHost:           host,
+		DbName:           "postgres",
+		DbPort:           6543,
+		DbUser:           poolerUser("postgres", p.project.Ref),
+		Identifier:       p.project.Ref,
+		PoolMode:         api.SupavisorConfigResponsePoolModeTransaction,
+	}})
+}
+
+func (f *fakeManagementAPI) listApiKeys(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	reveal := r.URL.Query().Get("reveal") == "true"
+	result := []api.ApiKeyResponse{}
+	for _, key := range p.apiKeys {
+		if key.Type.MustGet() == api.ApiKeyResponseTypeLegacy && !p.legacyApiKeysEnabled {
+			continue
+		}
+		result = append(result, maskApiKey(key, reveal))
+	}
+	writeFakeJSON(w, http.StatusOK, result)
+}
+
+func (f *fakeManagementAPI) createApiKey(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	var body api.CreateApiKeyBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	keyType := api.ApiKeyResponseType(body.Type)
+	name := strings.ToLower(body.Name)
+	for _, key := range p.apiKeys {
+		if key.Name == name && key.Type.MustGet() == keyType {
+			writeFakeError(w, http.StatusConflict, "API key with this name already exists")
+			return
+		}
+	}
+
+	id := uuid.NewString()
+	prefix := "sb_" + string(keyType) + "_"
+	now := time.Now().UTC()
+	key := api.ApiKeyResponse{
+		Name:        name,
+		Id:          nullable.NewNullableWithValue(id),
+		ApiKey:      nullable.NewNullableWithValue(prefix + strings.ReplaceAll(id, "-", "")),
+		Prefix:      nullable.NewNullableWithValue(prefix + id[:4]),
+		Type:        nullable.NewNullableWithValue(keyType),
+		Description: body.Description,
+		InsertedAt:  nullable.NewNullableWithValue(now),
+		UpdatedAt:   nullable.NewNullableWithValue(now),
+	}
+	if keyType == api.ApiKeyResponseTypeSecret {
+		key.SecretJwtTemplate = body.SecretJwtTemplate
+	}
+	p.apiKeys = append(p.apiKeys, key)
+	writeFakeJSON(w, http.StatusCreated, maskApiKey(key, r.URL.Query().Get("reveal") == "true"))
+}
+
+// apiKeyIndex returns the index of the key in the path, writing a 404 if missing.
+func (p *fakeProject) apiKeyIndex(w http.ResponseWriter, r *http.Request) (int, bool) {
+	i := slices.IndexFunc(p.apiKeys, func(key api.ApiKeyResponse) bool {
+		return key.Id.MustGet() == r.PathValue("id")
+	})
+	if i < 0 {
+		writeFakeError(w, http.StatusNotFound, "API key not found")
+	}
+	return i, i >= 0
+}
+
+func (f *fakeManagementAPI) getApiKey(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	if i, ok := p.apiKeyIndex(w, r); ok {
+		writeFakeJSON(w, http.StatusOK, maskApiKey(p.apiKeys[i], r.URL.Query().Get("reveal") == "true"))
+	}
+}
+
+func (f *fakeManagementAPI) updateApiKey(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	i, ok := p.apiKeyIndex(w, r)
+	if !ok {
+		return
+	}
+	var body api.UpdateApiKeyBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	key := &p.apiKeys[i]
+	if body.Name != nil {
+		key.Name = strings.ToLower(*body.Name)
+	}
+	if body.Description.IsSpecified() {
+		key.Description = body.Description
+	}
+	if body.SecretJwtTemplate.IsSpecified() && key.Type.MustGet() == api.ApiKeyResponseTypeSecret {
+		key.SecretJwtTemplate = body.SecretJwtTemplate
+	}
+	key.UpdatedAt = nullable.NewNullableWithValue(time.Now().UTC())
+	writeFakeJSON(w, http.StatusOK, maskApiKey(*key, r.URL.Query().Get("reveal") == "true"))
+}
+
+func (f *fakeManagementAPI) deleteApiKey(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	i, ok := p.apiKeyIndex(w, r)
+	if !ok {
+		return
+	}
+	key := p.apiKeys[i]
+	p.apiKeys = slices.Delete(p.apiKeys, i, i+1)
+	writeFakeJSON(w, http.StatusOK, maskApiKey(key, r.URL.Query().Get("reveal") == "true"))
+}
+
+// maskApiKey hides secret key values unless they are explicitly revealed.
+func maskApiKey(key api.ApiKeyResponse, reveal bool) api.ApiKeyResponse {
+	if !reveal && key.Type.MustGet() == api.ApiKeyResponseTypeSecret {
+		key.ApiKey = nullable.NewNullableWithValue(key.Prefix.MustGet() + "····")
+	}
+	return key
+}
+
+func (f *fakeManagementAPI) getLegacyApiKeys(w http.ResponseWriter, r *http.Request) {
+	if p, ok := f.lookup(w, r); ok {
+		writeFakeJSON(w, http.StatusOK, api.LegacyApiKeysResponse{Enabled: p.legacyApiKeysEnabled})
+	}
+}
+
+func (f *fakeManagementAPI) updateLegacyApiKeys(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	enabled, err := strconv.ParseBool(r.URL.Query().Get("enabled"))
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "enabled must be a boolean")
+		return
+	}
+	p.legacyApiKeysEnabled = enabled
+	writeFakeJSON(w, http.StatusOK, api.LegacyApiKeysResponse{Enabled: enabled})
+}
+
+func (f *fakeManagementAPI) listSecrets(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	result := []api.SecretResponse{}
+	for _, name := range slices.Sorted(maps.Keys(p.secrets)) {
+		result = append(result, p.secrets[name])
+	}
+	writeFakeJSON(w, http.StatusOK, result)
+}
+
+func (f *fakeManagementAPI) createSecrets(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	var body api.CreateSecretBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	for _, secret := range body {
+		if strings.HasPrefix(secret.Name, supabasePrefix) {
+			writeFakeError(w, http.StatusBadRequest, "Secret names must not start with "+supabasePrefix)
+			return
+		}
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	for _, secret := range body {
+		// Values are never returned, only their digest
+		p.secrets[secret.Name] = api.SecretResponse{Name: secret.Name, Value: computeSecretDigest(secret.Value), UpdatedAt: &now}
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (f *fakeManagementAPI) deleteSecrets(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	var names []string
+	if !readFakeJSON(w, r, &names) {
+		return
+	}
+	for _, name := range names {
+		if strings.HasPrefix(name, supabasePrefix) {
+			writeFakeError(w, http.StatusBadRequest, "Secret names must not start with "+supabasePrefix)
+			return
+		}
+	}
+	for _, name := range names {
+		delete(p.secrets, name)
+	}
+	writeFakeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (f *fakeManagementAPI) listFunctions(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	result := []api.FunctionResponse{}
+	for _, slug := range slices.Sorted(maps.Keys(p.functions)) {
+		result = append(result, p.functions[slug].function)
+	}
+	writeFakeJSON(w, http.StatusOK, result)
+}
+
+func (f *fakeManagementAPI) deployFunction(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	slug := r.URL.Query().Get("slug")
+	if slug == "" {
+		writeFakeError(w, http.StatusBadRequest, "slug is required")
+		return
+	}
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		writeFakeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var meta functionMetadata
+	if err := json.Unmarshal([]byte(r.FormValue("metadata")), &meta); err != nil || meta.EntrypointPath == "" {
+		writeFakeError(w, http.StatusBadRequest, "metadata with entrypoint_path is required")
+		return
+	}
+
+	files := map[string]string{}
+	for _, fh := range r.MultipartForm.File["file"] {
+		src, err := fh.Open()
+		if err != nil {
+			writeFakeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		content, err := io.ReadAll(src)
+		_ = src.Close()
+		if err != nil {
+			writeFakeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		files[fakeFunctionSourcePath+fh.Filename] = string(content)
+	}
+	if _, ok := files[fakeFunctionSourcePath+meta.EntrypointPath]; !ok {
+		writeFakeError(w, http.StatusBadRequest, "Entrypoint file is missing from the bundle")
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	fn, ok := p.functions[slug]
+	if !ok {
+		fn = &fakeFunction{function: api.FunctionResponse{
+			Id:        uuid.NewString(),
+			Slug:      slug,
+			CreatedAt: now,
+			VerifyJwt: Ptr(true),
+		}}
+		p.functions[slug] = fn
+	}
+	fn.files = files
+	fn.function.Name = meta.Name
+	fn.function.Status = api.FunctionResponseStatusACTIVE
+	fn.function.Version++
+	fn.function.UpdatedAt = now
+	fn.function.EntrypointPath = Ptr("file://" + fakeFunctionSourcePath + meta.EntrypointPath)
+	fn.function.ImportMap = Ptr(meta.ImportMapPath != nil)
+	fn.function.ImportMapPath = nil
+	if meta.ImportMapPath != nil {
+		fn.function.ImportMapPath = Ptr("file://" + fakeFunctionSourcePath + *meta.ImportMapPath)
+	}
+	fn.function.EzbrSha256 = Ptr(fakeBundleChecksum(files))
+
+	writeFakeJSON(w, http.StatusCreated, api.DeployFunctionResponse{
+		Id:             fn.function.Id,
+		Slug:           fn.function.Slug,
+		Name:           fn.function.Name,
+		Status:         api.DeployFunctionResponseStatus(fn.function.Status),
+		Version:        fn.function.Version,
+		CreatedAt:      &fn.function.CreatedAt,
+		UpdatedAt:      &fn.function.UpdatedAt,
+		EntrypointPath: fn.function.EntrypointPath,
+		ImportMap:      fn.function.ImportMap,
+		ImportMapPath:  fn.function.ImportMapPath,
+		EzbrSha256:     fn.function.EzbrSha256,
+		VerifyJwt:      fn.function.VerifyJwt,
+	})
+}
+
+// fakeBundleChecksum hashes the files of a bundle in a stable order.
+func fakeBundleChecksum(files map[string]string) string {
+	hash := sha256.New()
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		_, _ = fmt.Fprintf(hash, "%s\x00%s\x00", name, files[name])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// lookupFunction finds the function in the path, writing a 404 if missing.
+func (f *fakeManagementAPI) lookupFunction(w http.ResponseWriter, r *http.Request) (*fakeProject, *fakeFunction, bool) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	fn, ok := p.functions[r.PathValue("slug")]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, "Function not found")
+	}
+	return p, fn, ok
+}
+
+func (f *fakeManagementAPI) getFunction(w http.ResponseWriter, r *http.Request) {
+	if _, fn, ok := f.lookupFunction(w, r); ok {
+		writeFakeJSON(w, http.StatusOK, fn.function)
+	}
+}
+
+func (f *fakeManagementAPI) getFunctionBody(w http.ResponseWriter, r *http.Request) {
+	_, fn, ok := f.lookupFunction(w, r)
+	if !ok {
+		return
+	}
+	if !strings.Contains(r.Header.Get("Accept"), "multipart/form-data") {
+		writeFakeError(w, http.StatusNotAcceptable, "Only multipart/form-data bodies are served")
+		return
+	}
+
+	w.Header().Set("Content-Type", "multipart/form-data; boundary=fakeboundary")
+	w.WriteHeader(http.StatusOK)
+	writer := multipart.NewWriter(w)
+	_ = writer.SetBoundary("fakeboundary")
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="metadata"`)
+	header.Set("Content-Type", "application/json")
+	if part, err := writer.CreatePart(header); err == nil {
+		entrypoint := strings.TrimPrefix(*fn.function.EntrypointPath, "file://")
+		_ = json.NewEncoder(part).Encode(bundleMetadata{EntrypointPath: entrypoint})
+	}
+	for _, name := range slices.Sorted(maps.Keys(fn.files)) {
+		if part, err := writer.CreateFormFile("file", name); err == nil {
+			_, _ = io.WriteString(part, fn.files[name])
+		}
+	}
+	_ = writer.Close()
+}
+
+func (f *fakeManagementAPI) deleteFunction(w http.ResponseWriter, r *http.Request) {
+	p, fn, ok := f.lookupFunction(w, r)
+	if !ok {
+		return
+	}
+	delete(p.functions, fn.function.Slug)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (f *fakeManagementAPI) listBranches(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookupProject(w, r)
+	if !ok {
+		return
+	}
+	result := []api.BranchResponse{}
+	for _, b := range f.branches {
+		if b.branch.ParentProjectRef == p.project.Ref {
+			result = append(result, b.branch)
+		}
+	}
+	slices.SortFunc(result, func(a, b api.BranchResponse) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	writeFakeJSON(w, http.StatusOK, result)
+}
+
+func (f *fakeManagementAPI) createBranch(w http.ResponseWriter, r *http.Request) {
+	parent, ok := f.lookupProject(w, r)
+	if !ok {
+		return
+	}
+	var body api.CreateBranchBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	for _, b := range f.branches {
+		if b.branch.ParentProjectRef == parent.project.Ref && b.branch.Name == body.BranchName {
+			writeFakeError(w, http.StatusConflict, "Branch with this name already exists")
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+	branch := api.BranchResponse{
+		Id:               uuid.New(),
+		Name:             body.BranchName,
+		GitBranch:        body.GitBranch,
+		ParentProjectRef: parent.project.Ref,
+		ProjectRef:       parent.project.Ref,
+		Status:           api.BranchResponseStatusFUNCTIONSDEPLOYED,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if body.IsDefault != nil && *body.IsDefault {
+		// The default branch is the parent project itself
+		branch.IsDefault = true
+		branch.Persistent = true
+	} else {
+		region := parent.project.Region
+		if body.Region != nil && *body.Region != "" {
+			region = *body.Region
+		}
+		p := f.newProject(body.BranchName, parent.project.OrganizationId, region, true)
+		p.password = uuid.NewString()
+		p.transition(api.V1ProjectWithDatabaseResponseStatusCOMINGUP, api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY)
+		branch.ProjectRef = p.project.Ref
+		branch.Persistent = body.Persistent != nil && *body.Persistent
+	}
+	f.branches[branch.Id.String()] = &fakeBranch{branch: branch}
+
+	writeFakeJSON(w, http.StatusCreated, branch)
+}
+
+// lookupBranch finds a branch by id or project ref, writing a 404 if missing.
+func (f *fakeManagementAPI) lookupBranch(w http.ResponseWriter, r *http.Request) (*fakeBranch, bool) {
+	id := r.PathValue("id")
+	if b, ok := f.branches[id]; ok {
+		return b, true
+	}
+	for _, b := range f.branches {
+		if b.branch.ProjectRef == id && !b.branch.IsDefault {
+			return b, true
+		}
+	}
+	writeFakeError(w, http.StatusNotFound, "Branch not found")
+	return nil, false
+}
+
+func (f *fakeManagementAPI) getBranch(w http.ResponseWriter, r *http.Request) {
+	b, ok := f.lookupBranch(w, r)
+	if !ok {
+		return
+	}
+	p := f.projects[b.branch.ProjectRef]
+	writeFakeJSON(w, http.StatusOK, api.BranchDetailResponse{
+		Ref:             p.project.Ref,
+		DbHost:          p.project.Database.Host,
+		DbPort:          5432,
+		DbUser:          Ptr("postgres"),
+		DbPass:          Ptr(p.password),
+		JwtSecret:       Ptr("super-secret-jwt-token-" + p.project.Ref),
+		PostgresEngine:  p.project.Database.PostgresEngine,
+		PostgresVersion: p.project.Database.Version,
+		ReleaseChannel:  p.project.Database.ReleaseChannel,
+		Status:          api.BranchDetailResponseStatus(p.project.Status),
+	})
+	p.advance()
+}
+
+func (f *fakeManagementAPI) updateBranch(w http.ResponseWriter, r *http.Request) {
+	b, ok := f.lookupBranch(w, r)
+	if !ok {
+		return
+	}
+	var body api.UpdateBranchBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	if body.BranchName != nil {
+		b.branch.Name = *body.BranchName
+	}
+	if body.GitBranch != nil {
+		b.branch.GitBranch = body.GitBranch
+	}
+	if body.Persistent != nil {
+		b.branch.Persistent = *body.Persistent
+	}
+	b.branch.UpdatedAt = time.Now().UTC()
+	writeFakeJSON(w, http.StatusOK, b.branch)
+}
+
+func (f *fakeManagementAPI) deleteBranch(w http.ResponseWriter, r *http.Request) {
+	b, ok := f.lookupBranch(w, r)
+	if !ok {
+		return
+	}
+	if b.branch.Persistent {
+		writeFakeError(w, http.StatusUnprocessableEntity, "Persistent branches must be demoted before deletion")
+		return
+	}
+	delete(f.projects, b.branch.ProjectRef)
+	delete(f.branches, b.branch.Id.String())
+	writeFakeJSON(w, http.StatusOK, map[string]any{"message": "ok"})
+}
+
+func (f *fakeManagementAPI) listThirdPartyAuth(w http.ResponseWriter, r *http.Request) {
+	if p, ok := f.lookup(w, r); ok {
+		writeFakeJSON(w, http.StatusOK, append([]api.ThirdPartyAuth{}, p.thirdPartyAuth...))
+	}
+}
+
+func (f *fakeManagementAPI) createThirdPartyAuth(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	var body api.CreateThirdPartyAuthBody
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	tpa := api.ThirdPartyAuth{
+		Id:         uuid.New(),
+		InsertedAt: now,
+		UpdatedAt:  now,
+	}
+	switch {
+	case body.OidcIssuerUrl != nil:
+		tpa.Type = "oidc"
+		tpa.OidcIssuerUrl = nullable.NewNullableWithValue(*body.OidcIssuerUrl)
+	case body.JwksUrl != nil:
+		tpa.Type = "jwks"
+		tpa.JwksUrl = nullable.NewNullableWithValue(*body.JwksUrl)
+	case body.CustomJwks != nil:
+		tpa.Type = "custom"
+		tpa.CustomJwks = nullable.NewNullableWithValue(*body.CustomJwks)
+	default:
+		writeFakeError(w, http.StatusBadRequest, "One of oidc_issuer_url, jwks_url or custom_jwks is required")
+		return
+	}
+	p.thirdPartyAuth = append(p.thirdPartyAuth, tpa)
+	writeFakeJSON(w, http.StatusCreated, tpa)
+}
+
+// thirdPartyAuthIndex returns the index of the integration in the path,
+// writing a 404 if missing.
+func (p *fakeProject) thirdPartyAuthIndex(w http.ResponseWriter, r *http.Request) (int, bool) {
+	i := slices.IndexFunc(p.thirdPartyAuth, func(tpa api.ThirdPartyAuth) bool {
+		return tpa.Id.String() == r.PathValue("id")
+	})
+	if i < 0 {
+		writeFakeError(w, http.StatusNotFound, "Third-party auth integration not found")
+	}
+	return i, i >= 0
+}
+
+func (f *fakeManagementAPI) getThirdPartyAuth(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	if i, ok := p.thirdPartyAuthIndex(w, r); ok {
+		writeFakeJSON(w, http.StatusOK, p.thirdPartyAuth[i])
+	}
+}
+
+func (f *fakeManagementAPI) deleteThirdPartyAuth(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	i, ok := p.thirdPartyAuthIndex(w, r)
+	if !ok {
+		return
+	}
+	tpa := p.thirdPartyAuth[i]
+	p.thirdPartyAuth = slices.Delete(p.thirdPartyAuth, i, i+1)
+	writeFakeJSON(w, http.StatusOK, tpa)
+}
+
+func (f *fakeManagementAPI) getConfig(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if p, ok := f.lookup(w, r); ok {
+			writeFakeJSON(w, http.StatusOK, p.configs[kind])
+		}
+	}
+}
+
+// updateConfig merges the request into the stored config, as partial updates
+// of the real API do.
+func (f *fakeManagementAPI) updateConfig(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		p, ok := f.lookup(w, r)
+		if !ok {
+			return
+		}
+		var body map[string]any
+		if !readFakeJSON(w, r, &body) {
+			return
+		}
+		mergeFakeConfig(p.configs[kind], body)
+		writeFakeJSON(w, http.StatusOK, p.configs[kind])
+	}
+}
+
+// mergeFakeConfig recursively copies source into target.
+func mergeFakeConfig(target, source map[string]any) {
+	for key, value := range source {
+		nested, ok := value.(map[string]any)
+		existing, exists := target[key].(map[string]any)
+		if ok && exists {
+			mergeFakeConfig(existing, nested)
+			continue
+		}
+		target[key] = value
+	}
+}
+
+func (f *fakeManagementAPI) getNetworkRestrictions(w http.ResponseWriter, r *http.Request) {
+	if p, ok := f.lookup(w, r); ok {
+		writeFakeJSON(w, http.StatusOK, p.networkRestrictions())
+	}
+}
+
+func (f *fakeManagementAPI) updateNetworkRestrictions(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	var body api.NetworkRestrictionsRequest
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	if body.DbAllowedCidrs != nil {
+		p.allowedCidrs = *body.DbAllowedCidrs
+	}
+	if body.DbAllowedCidrsV6 != nil {
+		p.allowedCidrsV6 = *body.DbAllowedCidrsV6
+	}
+	writeFakeJSON(w, http.StatusCreated, p.networkRestrictions())
+}
+
+func (p *fakeProject) networkRestrictions() api.NetworkRestrictionsResponse {
+	var resp api.NetworkRestrictionsResponse
+	resp.Config.DbAllowedCidrs = Ptr(slices.Clone(p.allowedCidrs))
+	resp.Config.DbAllowedCidrsV6 = Ptr(slices.Clone(p.allowedCidrsV6))
+	resp.Entitlement = api.NetworkRestrictionsResponseEntitlementAllowed
+	resp.Status = api.NetworkRestrictionsResponseStatusApplied
+	return resp
+}
+
+func (f *fakeManagementAPI) getSslEnforcement(w http.ResponseWriter, r *http.Request) {
+	if p, ok := f.lookup(w, r); ok {
+		writeFakeJSON(w, http.StatusOK, p.sslEnforcementResponse())
+	}
+}
+
+func (f *fakeManagementAPI) updateSslEnforcement(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.lookup(w, r)
+	if !ok {
+		return
+	}
+	var body api.SslEnforcementRequest
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	p.sslEnforcement = body.RequestedConfig.Database
+	writeFakeJSON(w, http.StatusOK, p.sslEnforcementResponse())
+}
+
+func (p *fakeProject) sslEnforcementResponse() api.SslEnforcementResponse {
+	var resp api.SslEnforcementResponse
+	resp.AppliedSuccessfully = true
+	resp.CurrentConfig.Database = p.sslEnforcement
+	return resp
+}
+
+func readFakeJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeFakeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, message string) {
+	writeFakeJSON(w, status, map[string]string{"message": message})
+}
+
+func TestFakeManagementAPI_ProjectLifecycle(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	client := fake.client(t)
+
+	data := ProjectResourceModel{
+		OrganizationId: types.StringValue("continued-brown-smelt"),
+		Name:           types.StringValue("foo"),
+		Region:         types.StringValue("eu-west-1"),
+		InstanceSize:   types.StringValue("micro"),
+	}
+	if diags := createProject(t.Context(), &data, "password", client, time.Minute); diags.HasError() {
+		t.Fatalf("Expected project to become active, got errors: %v", diags)
+	}
+	if diags := readProject(t.Context(), &data, client); diags.HasError() {
+		t.Fatalf("Expected project to be read, got errors: %v", diags)
+	}
+	if data.Status.ValueString() != string(api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY) {
+		t.Errorf("Expected active project, got %s", data.Status)
+	}
+	if data.Region.ValueString() != "eu-west-1" || data.InstanceSize.ValueString() != "micro" {
+		t.Errorf("Expected region and instance size from create, got %s and %s", data.Region, data.InstanceSize)
+	}
+	if data.PoolerHost.ValueString() != "aws-0-eu-west-1.pooler.supabase.com" {
+		t.Errorf("Expected regional pooler host, got %s", data.PoolerHost)
+	}
+
+	data.Name = types.StringValue("bar")
+	data.InstanceSize = types.StringValue("small")
+	data.LegacyApiKeysEnabled = types.BoolValue(false)
+	for _, update := range []func() diag.Diagnostics{
+		func() diag.Diagnostics { return updateName(t.Context(), &data, client) },
+		func() diag.Diagnostics { return updateInstanceSize(t.Context(), &data, client, time.Minute) },
+		func() diag.Diagnostics { return updateLegacyAPIKeysEnabled(t.Context(), &data, client) },
+	} {
+		if diags := update(); diags.HasError() {
+			t.Fatalf("Expected update to succeed, got errors: %v", diags)
+		}
+	}
+	var updated ProjectResourceModel
+	updated.Id = data.Id
+	if diags := readProject(t.Context(), &updated, client); diags.HasError() {
+		t.Fatalf("Expected project to be read, got errors: %v", diags)
+	}
+	if updated.Name.ValueString() != "bar" || updated.InstanceSize.ValueString() != "small" || updated.LegacyApiKeysEnabled.ValueBool() {
+		t.Errorf("Expected updates to be persisted, got %s, %s, %s", updated.Name, updated.InstanceSize, updated.LegacyApiKeysEnabled)
+	}
+
+	if diags := deleteProject(t.Context(), &data, client); diags.HasError() {
+		t.Fatalf("Expected project to be deleted, got errors: %v", diags)
+	}
+	if status := fake.projectStatus(data.Id.ValueString()); status != "" {
+		t.Errorf("Expected project to be gone, got status %s", status)
+	}
+}
+
+func TestFakeManagementAPI_PauseAndRestore(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	client := fake.client(t)
+	ref := fake.addProject("foo")
+
+	if _, err := client.V1PauseAProjectWithResponse(t.Context(), ref); err != nil {
+		t.Fatalf("Failed to pause project: %v", err)
+	}
+	if status := fake.projectStatus(ref); status != api.V1ProjectWithDatabaseResponseStatusPAUSING {
+		t.Errorf("Expected pausing project, got %s", status)
+	}
+	if diags := waitForProjectPaused(t.Context(), ref, client, time.Minute); diags.HasError() {
+		t.Fatalf("Expected project to pause, got errors: %v", diags)
+	}
+
+	if _, err := client.V1RestoreAProjectWithResponse(t.Context(), ref); err != nil {
+		t.Fatalf("Failed to restore project: %v", err)
+	}
+	if diags := waitForProjectActive(t.Context(), ref, client, time.Minute); diags.HasError() {
+		t.Fatalf("Expected project to become active, got errors: %v", diags)
+	}
+}
+
+func TestFakeManagementAPI_BranchLifecycle(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	client := fake.client(t)
+	ref := fake.addProject("foo")
+
+	data := BranchResourceModel{
+		ParentProjectRef: types.StringValue(ref),
+		GitBranch:        types.StringValue("develop"),
+		Persistent:       types.BoolValue(true),
+	}
+	if diags := createBranch(t.Context(), &data, client); diags.HasError() {
+		t.Fatalf("Expected branch to be created, got errors: %v", diags)
+	}
+
+	branches, err := client.V1ListAllBranchesWithResponse(t.Context(), ref)
+	if err != nil || branches.JSON200 == nil {
+		t.Fatalf("Failed to list branches: %v", err)
+	}
+	if len(*branches.JSON200) != 2 || !(*branches.JSON200)[0].IsDefault {
+		t.Errorf("Expected default branch to be created before develop, got %+v", *branches.JSON200)
+	}
+
+	// Branch refs are only served by the branches endpoint
+	branchRef := (*branches.JSON200)[1].ProjectRef
+	if diags := waitForProjectActive(t.Context(), branchRef, client, time.Minute); diags.HasError() {
+		t.Fatalf("Expected branch to become active, got errors: %v", diags)
+	}
+	if diags := readBranch(t.Context(), &data, client); diags.HasError() {
+		t.Fatalf("Expected branch to be read, got errors: %v", diags)
+	}
+	if status := data.Database.Attributes()["status"]; status.String() != `"ACTIVE_HEALTHY"` {
+		t.Errorf("Expected active branch database, got %s", status)
+	}
+
+	// Persistent branches are demoted before deletion
+	if diags := deleteBranch(t.Context(), &data, client); diags.HasError() {
+		t.Fatalf("Expected branch to be deleted, got errors: %v", diags)
+	}
+	if status := fake.projectStatus(branchRef); status != "" {
+		t.Errorf("Expected branch project to be gone, got status %s", status)
+	}
+}
+
+func TestFakeManagementAPI_Unauthorized(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	client, err := newApiClient(fake.server.URL, "wrong", "test", fake.server.Client())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	resp, err := client.V1ListAllProjectsWithResponse(t.Context())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		t.Errorf("Expected unauthorized status, got %d", resp.StatusCode())
+	}
+}
@@ -0,0 +1,491 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// GenerateOptions configures the generation of Terraform configuration for an
+// existing project.
+type GenerateOptions struct {
+	// ProjectRef is the reference ID of the project to generate configuration for.
+	ProjectRef string
+	// OutputDir is the directory that configuration files and function sources are written to.
+	OutputDir string
+	// Version is the provider version reported to the API.
+	Version string
+}
+
+const (
+	generatedMainFile      = "main.tf"
+	generatedResourcesFile = "resources.tf"
+	generatedImportsFile   = "imports.tf"
+)
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Generate reads an existing project together with its settings, edge functions,
+// secrets, API keys and third-party auth integrations, then writes matching
+// resources and import blocks to the output directory. The API endpoint and
+// access token are read from the same environment variables as the provider.
+func Generate(ctx context.Context, opts GenerateOptions) diag.Diagnostics {
+	endpoint := strings.TrimSpace(os.Getenv("SUPABASE_API_ENDPOINT"))
+	if endpoint == "" {
+		endpoint = defaultApiEndpoint
+	}
+	accessToken := strings.TrimSpace(os.Getenv("SUPABASE_ACCESS_TOKEN"))
+	if accessToken == "" {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Missing Supabase API Access Token",
+			"Set the access token using the SUPABASE_ACCESS_TOKEN environment variable",
+		)}
+	}
+
+	client, err := newApiClient(endpoint, accessToken, opts.Version, nil)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("NewClientWithResponses Failed, API is not usable.", err.Error())}
+	}
+
+	return generateConfig(ctx, opts.ProjectRef, opts.OutputDir, client)
+}
+
+func generateConfig(ctx context.Context, projectRef, outputDir string, client Client) diag.Diagnostics {
+	// Preflight collision check, existing configuration is never overwritten
+	for _, name := range []string{generatedMainFile, generatedResourcesFile, generatedImportsFile} {
+		if _, err := os.Stat(filepath.Join(outputDir, name)); err == nil {
+			msg := fmt.Sprintf("Unable to generate configuration, file already exists: %s", filepath.Join(outputDir, name))
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Generate Error", msg)}
+		}
+	}
+
+	g := newConfigGenerator(projectRef)
+
+	diags := g.addProject(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(g.addSettings(ctx, client)...)
+	diags.Append(g.addEdgeFunctionSecrets(ctx, client)...)
+	diags.Append(g.addApiKeys(ctx, client)...)
+	diags.Append(g.addThirdPartyAuth(ctx, client)...)
+	if diags.HasError() {
+		return diags
+	}
+	// Functions are read last so nothing is written to disk when another read fails
+	diags.Append(g.addEdgeFunctions(ctx, outputDir, client)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(g.write(outputDir)...)
+	return diags
+}
+
+// configGenerator accumulates the generated configuration of a single project.
+type configGenerator struct {
+	projectRef   string
+	projectLabel string
+
+	main      *hclwrite.File
+	resources *hclwrite.File
+	imports   *hclwrite.File
+
+	// labels tracks the resource names in use per resource type.
+	labels map[string][]string
+}
+
+func newConfigGenerator(projectRef string) *configGenerator {
+	g := &configGenerator{
+		projectRef: projectRef,
+		main:       hclwrite.NewEmptyFile(),
+		resources:  hclwrite.NewEmptyFile(),
+		imports:    hclwrite.NewEmptyFile(),
+		labels:     map[string][]string{},
+	}
+
+	body := g.main.Body()
+	providers := body.AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	providers.SetAttributeValue("supabase", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("supabase/supabase"),
+	}))
+	body.AppendNewline()
+	body.AppendNewBlock("provider", []string{"supabase"})
+
+	return g
+}
+
+// addResource appends a resource block with a matching import block and returns
+// the body of the resource block. Labels are derived from name and made unique
+// per resource type.
+func (g *configGenerator) addResource(typeName, name, importID string) *hclwrite.Body {
+	label := resourceLabel(name)
+	for i := 2; slices.Contains(g.labels[typeName], label); i++ {
+		label = fmt.Sprintf("%s_%d", resourceLabel(name), i)
+	}
+	g.labels[typeName] = append(g.labels[typeName], label)
+
+	body := g.resources.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	block := body.AppendNewBlock("resource", []string{typeName, label})
+
+	imports := g.imports.Body()
+	if len(imports.Blocks()) > 0 {
+		imports.AppendNewline()
+	}
+	importBody := imports.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: label},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(importID))
+
+	return block.Body()
+}
+
+// addVariable declares a sensitive input variable for values the API does not return.
+func (g *configGenerator) addVariable(name, description string, varType hclwrite.Tokens) {
+	body := g.main.Body()
+	body.AppendNewline()
+	variable := body.AppendNewBlock("variable", []string{name}).Body()
+	variable.SetAttributeValue("description", cty.StringVal(description))
+	variable.SetAttributeRaw("type", varType)
+	variable.SetAttributeValue("sensitive", cty.True)
+}
+
+// projectRefTraversal references the id of the generated project resource.
+func (g *configGenerator) projectRefTraversal() hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: "supabase_project"},
+		hcl.TraverseAttr{Name: g.projectLabel},
+		hcl.TraverseAttr{Name: "id"},
+	}
+}
+
+func (g *configGenerator) addProject(ctx context.Context, client Client) diag.Diagnostics {
+	data := ProjectResourceModel{Id: types.StringValue(g.projectRef)}
+	if diags := readProject(ctx, &data, client); diags.HasError() {
+		return diags
+	}
+	if data.Name.IsNull() {
+		msg := fmt.Sprintf("Project %s does not exist", g.projectRef)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Resource Not Found", msg)}
+	}
+
+	body := g.addResource("supabase_project", data.Name.ValueString(), g.projectRef)
+	g.projectLabel = g.labels["supabase_project"][0]
+	body.SetAttributeValue("organization_id", cty.StringVal(data.OrganizationId.ValueString()))
+	body.SetAttributeValue("name", cty.StringVal(data.Name.ValueString()))
+	body.SetAttributeValue("region", cty.StringVal(data.Region.ValueString()))
+	if !data.InstanceSize.IsNull() {
+		body.SetAttributeValue("instance_size", cty.StringVal(data.InstanceSize.ValueString()))
+	}
+	body.SetAttributeTraversal("database_password", hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: "database_password"},
+	})
+	body.AppendNewline()
+	lifecycle := body.AppendNewBlock("lifecycle", nil).Body()
+	lifecycle.AppendUnstructuredTokens(hclwrite.Tokens{{
+		Type:  hclsyntax.TokenComment,
+		Bytes: []byte("# The current password cannot be read from the API, so it is not reset on the first apply\n"),
+	}})
+	lifecycle.SetAttributeRaw("ignore_changes", hclwrite.TokensForTuple([]hclwrite.Tokens{
+		hclwrite.TokensForIdentifier("database_password"),
+	}))
+
+	g.addVariable("database_password", "Password of the project database", hclwrite.TokensForIdentifier("string"))
+	return nil
+}
+
+func (g *configGenerator) addSettings(ctx context.Context, client Client) diag.Diagnostics {
+	data := SettingsResourceModel{Id: types.StringValue(g.projectRef)}
+
+	var diags diag.Diagnostics
+	diags.Append(readDatabaseConfig(ctx, &data, client)...)
+	diags.Append(readNetworkConfig(ctx, &data, client)...)
+	diags.Append(readApiConfig(ctx, &data, client)...)
+	diags.Append(readAuthConfig(ctx, &data, client)...)
+	diags.Append(readStorageConfig(ctx, &data, client)...)
+	diags.Append(readSslEnforcementConfig(ctx, &data, client)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	body := g.addResource("supabase_settings", g.projectLabel, g.projectRef)
+	body.SetAttributeTraversal("project_ref", g.projectRefTraversal())
+	for _, setting := range []struct {
+		name  string
+		value jsontypes.Normalized
+	}{
+		{"database", data.Database},
+		{"network", data.Network},
+		{"api", data.Api},
+		{"auth", data.Auth},
+		{"storage", data.Storage},
+	} {
+		if setting.value.IsNull() {
+			continue
+		}
+		tokens, err := jsonencodeTokens(setting.value.ValueString())
+		if err != nil {
+			msg := fmt.Sprintf("Unable to generate %s settings, got error: %s", setting.name, err)
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Generate Error", msg)}
+		}
+		body.SetAttributeRaw(setting.name, tokens)
+	}
+	if !data.SslEnforcement.IsNull() {
+		body.SetAttributeValue("ssl_enforcement", cty.BoolVal(data.SslEnforcement.ValueBool()))
+	}
+
+	return nil
+}
+
+func (g *configGenerator) addEdgeFunctionSecrets(ctx context.Context, client Client) diag.Diagnostics {
+	secrets, diags := fetchEdgeFunctionSecrets(ctx, g.projectRef, client)
+	if diags.HasError() || secrets == nil {
+		return diags
+	}
+
+	var names []string
+	for _, secret := range *secrets {
+		// Reserved secrets cannot be managed
+		if !strings.HasPrefix(secret.Name, supabasePrefix) {
+			names = append(names, secret.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	slices.Sort(names)
+
+	// The API only returns digests, so values are supplied through a variable
+	elements := make([]hclwrite.Tokens, 0, len(names))
+	for _, name := range names {
+		elements = append(elements, hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+			{Name: hclwrite.TokensForIdentifier("name"), Value: hclwrite.TokensForValue(cty.StringVal(name))},
+			{Name: hclwrite.TokensForIdentifier("value"), Value: hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: "edge_function_secrets"},
+				hcl.TraverseIndex{Key: cty.StringVal(name)},
+			})},
+		}))
+	}
+
+	body := g.addResource("supabase_edge_function_secrets", g.projectLabel, g.projectRef)
+	body.SetAttributeTraversal("project_ref", g.projectRefTraversal())
+	body.SetAttributeRaw("secrets", hclwrite.TokensForTuple(elements))
+
+	g.addVariable("edge_function_secrets", "Values of the edge function secrets, keyed by name",
+		hclwrite.TokensForFunctionCall("map", hclwrite.TokensForIdentifier("string")))
+	return nil
+}
+
+func (g *configGenerator) addApiKeys(ctx context.Context, client Client) diag.Diagnostics {
+	httpResp, err := client.V1GetProjectApiKeysWithResponse(ctx, g.projectRef, &api.V1GetProjectApiKeysParams{})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list api keys, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list api keys, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	for _, key := range *httpResp.JSON200 {
+		if !key.Id.IsSpecified() || key.Id.IsNull() || !key.Type.IsSpecified() || key.Type.IsNull() {
+			continue
+		}
+		// Legacy keys cannot be managed
+		keyType := key.Type.MustGet()
+		if keyType != api.ApiKeyResponseTypePublishable && keyType != api.ApiKeyResponseTypeSecret {
+			continue
+		}
+
+		body := g.addResource("supabase_apikey", key.Name, g.projectRef+"/"+key.Id.MustGet())
+		body.SetAttributeTraversal("project_ref", g.projectRefTraversal())
+		body.SetAttributeValue("name", cty.StringVal(key.Name))
+		body.SetAttributeValue("type", cty.StringVal(string(keyType)))
+		if description := NullableToString(key.Description); description.ValueString() != "" {
+			body.SetAttributeValue("description", cty.StringVal(description.ValueString()))
+		}
+		if key.SecretJwtTemplate.IsSpecified() && !key.SecretJwtTemplate.IsNull() {
+			if role, ok := key.SecretJwtTemplate.MustGet()["role"].(string); ok && role != "" {
+				body.SetAttributeValue("secret_jwt_template", cty.ObjectVal(map[string]cty.Value{
+					"role": cty.StringVal(role),
+				}))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *configGenerator) addThirdPartyAuth(ctx context.Context, client Client) diag.Diagnostics {
+	httpResp, err := client.V1ListProjectTpaIntegrationsWithResponse(ctx, g.projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list third-party auth integrations, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list third-party auth integrations, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	for _, tpa := range *httpResp.JSON200 {
+		var data ThirdPartyAuthResourceModel
+		if diags := setThirdPartyAuthState(&data, tpa); diags.HasError() {
+			return diags
+		}
+
+		body := g.addResource("supabase_third_party_auth", tpa.Type, g.projectRef+"/"+data.Id.ValueString())
+		body.SetAttributeTraversal("project_ref", g.projectRefTraversal())
+		// Exactly one source may be configured, the API can return more once resolved
+		switch {
+		case !data.OIDCIssuerURL.IsNull():
+			body.SetAttributeValue("oidc_issuer_url", cty.StringVal(data.OIDCIssuerURL.ValueString()))
+		case !data.JWKSURL.IsNull():
+			body.SetAttributeValue("jwks_url", cty.StringVal(data.JWKSURL.ValueString()))
+		case !data.CustomJWKS.IsNull():
+			tokens, err := jsonencodeTokens(data.CustomJWKS.ValueString())
+			if err != nil {
+				msg := fmt.Sprintf("Unable to generate custom JWKS, got error: %s", err)
+				return diag.Diagnostics{diag.NewErrorDiagnostic("Generate Error", msg)}
+			}
+			body.SetAttributeRaw("custom_jwks", tokens)
+		}
+	}
+
+	return nil
+}
+
+func (g *configGenerator) addEdgeFunctions(ctx context.Context, outputDir string, client Client) diag.Diagnostics {
+	httpResp, err := client.V1ListAllFunctionsWithResponse(ctx, g.projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list edge functions, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list edge functions, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	// Collision check before any source is downloaded, existing function sources are never overwritten
+	for _, fn := range *httpResp.JSON200 {
+		functionDir := filepath.Join(outputDir, "supabase", "functions", fn.Slug)
+		if _, err := os.Stat(functionDir); err == nil {
+			msg := fmt.Sprintf("Unable to generate configuration, directory already exists: %s", functionDir)
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Generate Error", msg)}
+		}
+	}
+
+	var diags diag.Diagnostics
+	for _, fn := range *httpResp.JSON200 {
+		data := EdgeFunctionResourceModel{
+			ProjectRef: types.StringValue(g.projectRef),
+			Slug:       types.StringValue(fn.Slug),
+		}
+		functionDir := filepath.Join(outputDir, "supabase", "functions", fn.Slug)
+		if downloadDiags := downloadFunctionSource(ctx, &data, functionDir, client, fn.EntrypointPath, fn.ImportMapPath); downloadDiags.HasError() {
+			diags.AddWarning(
+				"Source Download Unavailable",
+				fmt.Sprintf("Could not download the source code of function %s, no configuration was generated for it: %s",
+					fn.Slug, downloadDiags.Errors()[0].Detail()),
+			)
+			continue
+		}
+
+		body := g.addResource("supabase_edge_function", fn.Slug, g.projectRef+"/"+fn.Slug)
+		body.SetAttributeTraversal("project_ref", g.projectRefTraversal())
+		body.SetAttributeValue("slug", cty.StringVal(fn.Slug))
+		if fn.Name != fn.Slug {
+			body.SetAttributeValue("name", cty.StringVal(fn.Name))
+		}
+		for _, source := range []struct {
+			name  string
+			value types.String
+		}{
+			{"entrypoint", data.Entrypoint},
+			{"import_map", data.ImportMap},
+		} {
+			if source.value.IsNull() {
+				continue
+			}
+			// Paths are relative to the generated configuration
+			relPath, err := filepath.Rel(outputDir, source.value.ValueString())
+			if err != nil {
+				relPath = source.value.ValueString()
+			}
+			body.SetAttributeValue(source.name, cty.StringVal(filepath.ToSlash(relPath)))
+		}
+	}
+
+	return diags
+}
+
+// write formats and saves the generated files to outputDir.
+func (g *configGenerator) write(outputDir string) diag.Diagnostics {
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		msg := fmt.Sprintf("Unable to create output directory, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Generate Error", msg)}
+	}
+
+	for name, file := range map[string]*hclwrite.File{
+		generatedMainFile:      g.main,
+		generatedResourcesFile: g.resources,
+		generatedImportsFile:   g.imports,
+	} {
+		path := filepath.Join(outputDir, name)
+		if err := os.WriteFile(path, hclwrite.Format(file.Bytes()), 0o644); err != nil { //nolint:gosec // G306: configuration holds no secrets, values are passed as variables
+			msg := fmt.Sprintf("Unable to write %s, got error: %s", path, err)
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Generate Error", msg)}
+		}
+	}
+
+	return nil
+}
+
+// resourceLabel converts a name into a valid Terraform resource name.
+func resourceLabel(name string) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		return "default"
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+	return label
+}
+
+// jsonencodeTokens renders a JSON document as a jsonencode call on the
+// equivalent HCL value, which is easier to edit than an embedded JSON string.
+func jsonencodeTokens(value string) (hclwrite.Tokens, error) {
+	ty, err := ctyjson.ImpliedType([]byte(value))
+	if err != nil {
+		return nil, err
+	}
+	val, err := ctyjson.Unmarshal([]byte(value), ty)
+	if err != nil {
+		return nil, err
+	}
+	return hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(val)), nil
+}
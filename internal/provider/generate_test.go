@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/oapi-codegen/nullable"
+	"github.com/supabase/cli/pkg/api"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestGenerateConfig(t *testing.T) {
+	defer gock.OffAll()
+	gock.InterceptClient(http.DefaultClient)
+	defer gock.RestoreClient(http.DefaultClient)
+
+	outputDir := t.TempDir()
+	apiEntrypoint := "file:///src/index.ts"
+
+	// Register nested paths first, gock matches paths as prefixes
+	mockMultipartBodyResponse(t, testProjectRef, functionSlug, "/src/index.ts", map[string]string{
+		"/src/index.ts": `Deno.serve((req) => new Response("Hello"));`,
+	})
+	gock.New(defaultApiEndpoint).
+		Get(functionsApiPath + "$").
+		Reply(http.StatusOK).
+		JSON([]api.FunctionResponse{{
+			Id:             "fn-id",
+			Slug:           functionSlug,
+			Name:           "Foo",
+			EntrypointPath: &apiEntrypoint,
+		}})
+	gock.New(defaultApiEndpoint).
+		Get(dbConfigApiPath).
+		Reply(http.StatusOK).
+		JSON(map[string]any{"statement_timeout": "10s"})
+	gock.New(defaultApiEndpoint).
+		Get(networkRestrictionsApiPath).
+		Reply(http.StatusNotFound)
+	gock.New(defaultApiEndpoint).
+		Get(postgrestApiPath).
+		Reply(http.StatusOK).
+		JSON(map[string]any{"db_schema": "public,storage", "max_rows": 1000})
+	gock.New(defaultApiEndpoint).
+		Get(thirdPartyAuthApiPath).
+		Reply(http.StatusOK).
+		JSON([]map[string]any{{
+			"id":              testThirdPartyAuthUUID,
+			"type":            "clerk",
+			"oidc_issuer_url": "https://clerk.example.com",
+			"jwks_url":        "https://clerk.example.com/.well-known/jwks.json",
+			"inserted_at":     "2024-01-01T00:00:00Z",
+			"updated_at":      "2024-01-01T00:00:00Z",
+		}})
+	gock.New(defaultApiEndpoint).
+		Get(authConfigApiPath).
+		Reply(http.StatusNotFound)
+	gock.New(defaultApiEndpoint).
+		Get(storageConfigApiPath).
+		Reply(http.StatusNotFound)
+	gock.New(defaultApiEndpoint).
+		Get(sslEnforcementApiPath).
+		Reply(http.StatusOK).
+		JSON(map[string]any{"currentConfig": map[string]any{"database": true}, "appliedSuccessfully": true})
+	gock.New(defaultApiEndpoint).
+		Get(secretsApiPath).
+		Reply(http.StatusOK).
+		JSON([]api.SecretResponse{
+			{Name: "STRIPE_KEY", Value: "digest"},
+			{Name: "SUPABASE_URL", Value: "digest"},
+		})
+	gock.New(defaultApiEndpoint).
+		Get(apiKeysApiPath + "$").
+		Reply(http.StatusOK).
+		JSON([]api.ApiKeyResponse{
+			{Name: "anon", Id: nullable.NewNullableWithValue("anon"), Type: nullable.NewNullableWithValue(api.ApiKeyResponseTypeLegacy)},
+			{Name: "default", Id: nullable.NewNullableWithValue(testApiKeyUUID), Type: nullable.NewNullableWithValue(api.ApiKeyResponseTypeSecret)},
+		})
+	mockReadProject(1)
+
+	client, err := newClient(defaultApiEndpoint)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	diags := generateConfig(t.Context(), testProjectRef, outputDir, client)
+	if diags.HasError() {
+		t.Fatalf("Expected success, got errors: %v", diags)
+	}
+
+	resources, err := os.ReadFile(filepath.Join(outputDir, generatedResourcesFile))
+	if err != nil {
+		t.Fatalf("Failed to read generated resources: %v", err)
+	}
+	for _, expected := range []string{
+		`resource "supabase_project" "foo" {`,
+		`organization_id   = "continued-brown-smelt"`,
+		`database_password = var.database_password`,
+		`resource "supabase_settings" "foo" {`,
+		`project_ref = supabase_project.foo.id`,
+		`statement_timeout = "10s"`,
+		`ssl_enforcement = true`,
+		`value = var.edge_function_secrets["STRIPE_KEY"]`,
+		`resource "supabase_apikey" "default" {`,
+		`resource "supabase_third_party_auth" "clerk" {`,
+		`oidc_issuer_url = "https://clerk.example.com"`,
+		`resource "supabase_edge_function" "foo" {`,
+		`name        = "Foo"`,
+		`entrypoint  = "supabase/functions/foo/index.ts"`,
+	} {
+		if !strings.Contains(string(resources), expected) {
+			t.Errorf("Expected generated resources to contain %q, got:\n%s", expected, resources)
+		}
+	}
+	for _, unexpected := range []string{"SUPABASE_URL", `"anon"`, "jwks_url", "network"} {
+		if strings.Contains(string(resources), unexpected) {
+			t.Errorf("Expected generated resources not to contain %q, got:\n%s", unexpected, resources)
+		}
+	}
+
+	imports, err := os.ReadFile(filepath.Join(outputDir, generatedImportsFile))
+	if err != nil {
+		t.Fatalf("Failed to read generated imports: %v", err)
+	}
+	for _, expected := range []string{
+		"to = supabase_project.foo\n  id = \"" + testProjectRef + "\"",
+		"to = supabase_edge_function.foo\n  id = \"" + testProjectRef + "/" + functionSlug + "\"",
+		"to = supabase_apikey.default\n  id = \"" + testProjectRef + "/" + testApiKeyUUID + "\"",
+	} {
+		if !strings.Contains(string(imports), expected) {
+			t.Errorf("Expected generated imports to contain %q, got:\n%s", expected, imports)
+		}
+	}
+
+	main, err := os.ReadFile(filepath.Join(outputDir, generatedMainFile))
+	if err != nil {
+		t.Fatalf("Failed to read generated main: %v", err)
+	}
+	for _, expected := range []string{`variable "database_password" {`, `variable "edge_function_secrets" {`} {
+		if !strings.Contains(string(main), expected) {
+			t.Errorf("Expected generated main to contain %q, got:\n%s", expected, main)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(outputDir, "supabase", "functions", functionSlug, "index.ts")); err != nil {
+		t.Errorf("Expected function source to be downloaded: %v", err)
+	}
+}
+
+func TestGenerateConfig_ExistingFiles(t *testing.T) {
+	outputDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(outputDir, generatedMainFile), nil, 0o600); err != nil {
+		t.Fatalf("Failed to write main file: %v", err)
+	}
+
+	client, err := newClient(defaultApiEndpoint)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	diags := generateConfig(t.Context(), testProjectRef, outputDir, client)
+	if !diags.HasError() {
+		t.Fatalf("Expected error for existing configuration, got success")
+	}
+}
+
+func TestResourceLabel(t *testing.T) {
+	for name, expected := range map[string]string{
+		"production":       "production",
+		"My Project (EU)":  "my_project_eu",
+		"hello-world":      "hello_world",
+		"2024 launch":      "_2024_launch",
+		"---":              "default",
+		"service_role_key": "service_role_key",
+	} {
+		if label := resourceLabel(name); label != expected {
+			t.Errorf("resourceLabel(%q) = %q, expected %q", name, label, expected)
+		}
+	}
+}
+
+func TestGenerateConfig_FakeAPI(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	client := fake.client(t)
+	ref := fake.addProject("My App")
+	fake.addFunction(ref, "hello-world", "/src/index.ts", map[string]string{
+		"/src/index.ts": `Deno.serve(() => new Response("Hello"));`,
+	})
+
+	if resp, err := client.V1BulkCreateSecretsWithResponse(t.Context(), ref, api.CreateSecretBody{
+		{Name: "STRIPE_KEY", Value: "sk_test"},
+	}); err != nil || resp.StatusCode() != http.StatusCreated {
+		t.Fatalf("Failed to create secret: %v", err)
+	}
+	key, err := client.V1CreateProjectApiKeyWithResponse(t.Context(), ref, &api.V1CreateProjectApiKeyParams{}, api.CreateApiKeyBody{
+		Name: "backend",
+		Type: api.CreateApiKeyBodyTypeSecret,
+	})
+	if err != nil || key.JSON201 == nil {
+		t.Fatalf("Failed to create api key: %v", err)
+	}
+
+	outputDir := t.TempDir()
+	if diags := generateConfig(t.Context(), ref, outputDir, client); diags.HasError() {
+		t.Fatalf("Expected success, got errors: %v", diags)
+	}
+
+	resources, err := os.ReadFile(filepath.Join(outputDir, generatedResourcesFile))
+	if err != nil {
+		t.Fatalf("Failed to read generated resources: %v", err)
+	}
+	for _, expected := range []string{
+		`resource "supabase_project" "my_app" {`,
+		`project_ref = supabase_project.my_app.id`,
+		`db_schema            = "public,graphql_public"`,
+		`value = var.edge_function_secrets["STRIPE_KEY"]`,
+		`resource "supabase_apikey" "backend" {`,
+		`resource "supabase_edge_function" "hello_world" {`,
+	} {
+		if !strings.Contains(string(resources), expected) {
+			t.Errorf("Expected generated resources to contain %q, got:\n%s", expected, resources)
+		}
+	}
+
+	imports, err := os.ReadFile(filepath.Join(outputDir, generatedImportsFile))
+	if err != nil {
+		t.Fatalf("Failed to read generated imports: %v", err)
+	}
+	expected := "to = supabase_apikey.backend\n  id = \"" + ref + "/" + key.JSON201.Id.MustGet() + "\""
+	if !strings.Contains(string(imports), expected) {
+		t.Errorf("Expected generated imports to contain %q, got:\n%s", expected, imports)
+	}
+
+	if _, err := os.Stat(filepath.Join(outputDir, "supabase", "functions", "hello-world", "index.ts")); err != nil {
+		t.Errorf("Expected function source to be downloaded: %v", err)
+	}
+}
+
+func TestGenerateConfig_ExistingFunctionSource(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	client := fake.client(t)
+	ref := fake.addProject("My App")
+	fake.addFunction(ref, "hello-world", "/src/index.ts", map[string]string{
+		"/src/index.ts": `Deno.serve(() => new Response("Hello"));`,
+	})
+
+	outputDir := t.TempDir()
+	functionDir := filepath.Join(outputDir, "supabase", "functions", "hello-world")
+	if err := os.MkdirAll(functionDir, 0o755); err != nil {
+		t.Fatalf("Failed to create function directory: %v", err)
+	}
+	localSource := filepath.Join(functionDir, "index.ts")
+	if err := os.WriteFile(localSource, []byte("// local changes"), 0o600); err != nil {
+		t.Fatalf("Failed to write function source: %v", err)
+	}
+
+	diags := generateConfig(t.Context(), ref, outputDir, client)
+	if !diags.HasError() {
+		t.Fatalf("Expected error for existing function source, got success")
+	}
+	if content, err := os.ReadFile(localSource); err != nil || string(content) != "// local changes" {
+		t.Errorf("Expected existing function source to be kept, got %q: %v", content, err)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, generatedMainFile)); err == nil {
+		t.Errorf("Expected no configuration to be written")
+	}
+}
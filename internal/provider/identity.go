@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProjectRefIdentityModel describes the identity of resources that exist at most once per project.
+type ProjectRefIdentityModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+}
+
+func projectRefIdentity(projectRef types.String) ProjectRefIdentityModel {
+	return ProjectRefIdentityModel{ProjectRef: projectRef}
+}
+
+func projectRefIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_ref": identityschema.StringAttribute{
+				Description:       "Project reference ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// importProjectRef returns the project ref being imported, taken from the import ID
+// or, when importing by identity, from the identity.
+func importProjectRef(ctx context.Context, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	if req.ID != "" {
+		return req.ID, nil
+	}
+
+	var identity ProjectRefIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	return identity.ProjectRef.ValueString(), diags
+}
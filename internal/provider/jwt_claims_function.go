@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &JwtClaimsFunction{}
+
+var jwtClaimsAttrTypes = map[string]attr.Type{
+	"role": types.StringType,
+	"ref":  types.StringType,
+	"iat":  types.Int64Type,
+	"exp":  types.Int64Type,
+}
+
+func NewJwtClaimsFunction() function.Function {
+	return &JwtClaimsFunction{}
+}
+
+// JwtClaimsFunction defines the function implementation.
+type JwtClaimsFunction struct{}
+
+type jwtClaims struct {
+	Role *string `json:"role"`
+	Ref  *string `json:"ref"`
+	Iat  *int64  `json:"iat"`
+	Exp  *int64  `json:"exp"`
+}
+
+func (f *JwtClaimsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jwt_claims"
+}
+
+func (f *JwtClaimsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Decodes the claims of a Supabase JWT",
+		MarkdownDescription: "Decodes a JWT, such as a legacy `anon` or `service_role` API key, and returns its `role`, `ref`, `iat` and `exp` claims. " +
+			"Claims missing from the token are returned as `null`.\n\n" +
+			"~> The signature is **not** verified, so the claims must not be trusted for authorization. " +
+			"The function is meant for asserting that a key belongs to the expected project and role.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "token",
+				MarkdownDescription: "JWT to decode",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: jwtClaimsAttrTypes,
+		},
+	}
+}
+
+func (f *JwtClaimsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &token))
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := decodeJwtClaims(token)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(jwtClaimsAttrTypes, map[string]attr.Value{
+		"role": types.StringPointerValue(claims.Role),
+		"ref":  types.StringPointerValue(claims.Ref),
+		"iat":  types.Int64PointerValue(claims.Iat),
+		"exp":  types.Int64PointerValue(claims.Exp),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// decodeJwtClaims decodes the payload of a JWT without verifying its signature.
+func decodeJwtClaims(token string) (jwtClaims, error) {
+	var claims jwtClaims
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims, fmt.Errorf("token is not a JWT, expected 3 segments but got %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return claims, fmt.Errorf("failed to decode JWT payload: %w", err)
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, fmt.Errorf("failed to parse JWT claims: %w", err)
+	}
+
+	return claims, nil
+}
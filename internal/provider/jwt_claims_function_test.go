@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/oapi-codegen/nullable"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// testJwt builds an unsigned token with the given claims payload.
+func testJwt(payload string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	return header + "." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+func TestAccJwtClaimsFunction(t *testing.T) {
+	serviceRoleKey := testJwt(`{"iss":"supabase","ref":"` + testProjectRef + `","role":"service_role","iat":1700000000,"exp":2015576000}`)
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(apiKeysApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.ApiKeyResponse{
+			{
+				Name:   "service_role",
+				Type:   nullable.NewNullableWithValue(api.ApiKeyResponseTypeLegacy),
+				ApiKey: nullable.NewNullableWithValue(serviceRoleKey),
+			},
+		})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: examples.JwtClaimsFunctionConfig,
+			},
+			{
+				Config: `
+output "service_role" {
+  value = provider::supabase::jwt_claims("` + serviceRoleKey + `")
+}
+
+output "anon" {
+  value = provider::supabase::jwt_claims("` + testJwt(`{"role":"anon"}`) + `")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("service_role", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"role": knownvalue.StringExact("service_role"),
+						"ref":  knownvalue.StringExact(testProjectRef),
+						"iat":  knownvalue.Int64Exact(1700000000),
+						"exp":  knownvalue.Int64Exact(2015576000),
+					})),
+					statecheck.ExpectKnownOutputValue("anon", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"role": knownvalue.StringExact("anon"),
+						"ref":  knownvalue.Null(),
+						"iat":  knownvalue.Null(),
+						"exp":  knownvalue.Null(),
+					})),
+				},
+			},
+			{
+				Config: `
+output "secret" {
+  value = provider::supabase::jwt_claims("sb_secret_eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9")
+}
+`,
+				ExpectError: regexp.MustCompile(`token is not a JWT`),
+			},
+		},
+	})
+}
@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &JwtSigningKeyResource{}
+	_ resource.ResourceWithImportState = &JwtSigningKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &JwtSigningKeyResource{}
+	_ resource.ResourceWithIdentity    = &JwtSigningKeyResource{}
+)
+
+func NewJwtSigningKeyResource() resource.Resource {
+	return &JwtSigningKeyResource{}
+}
+
+// JwtSigningKeyResource defines the resource implementation.
+type JwtSigningKeyResource struct {
+	client Client
+}
+
+// JwtSigningKeyResourceModel describes the resource data model.
+type JwtSigningKeyResourceModel struct {
+	ProjectRef types.String         `tfsdk:"project_ref"`
+	Algorithm  types.String         `tfsdk:"algorithm"`
+	Status     types.String         `tfsdk:"status"`
+	PublicJwk  jsontypes.Normalized `tfsdk:"public_jwk"`
+	CreatedAt  types.String         `tfsdk:"created_at"`
+	UpdatedAt  types.String         `tfsdk:"updated_at"`
+	Id         types.String         `tfsdk:"id"`
+}
+
+type JwtSigningKeyResourceIdentityModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func jwtSigningKeyIdentity(data *JwtSigningKeyResourceModel) JwtSigningKeyResourceIdentityModel {
+	return JwtSigningKeyResourceIdentityModel{
+		ProjectRef: data.ProjectRef,
+		Id:         data.Id,
+	}
+}
+
+func (r *JwtSigningKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwt_signing_key"
+}
+
+func (r *JwtSigningKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "JWT signing key resource. Manages the asymmetric keys used to sign a project's auth tokens. " +
+			"Rotate by creating a `standby` key, distributing its `public_jwk`, then promoting it to `in_use`. " +
+			"The previous key becomes `previously_used` and can be revoked once its tokens have expired.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "Signing algorithm, one of `RS256` or `ES256`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.CreateSigningKeyBodyAlgorithmRS256),
+						string(api.CreateSigningKeyBodyAlgorithmES256),
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the key, one of `standby`, `in_use`, `previously_used` or `revoked`. " +
+					"New keys can only be created as `standby` or `in_use`. A key moved to `previously_used` by promoting another key " +
+					"keeps that status while configured as `standby` or `in_use`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(api.SigningKeyResponseStatusStandby)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.SigningKeyResponseStatusStandby),
+						string(api.SigningKeyResponseStatusInUse),
+						string(api.SigningKeyResponseStatusPreviouslyUsed),
+						string(api.SigningKeyResponseStatusRevoked),
+					),
+				},
+			},
+			"public_jwk": schema.StringAttribute{
+				CustomType:          jsontypes.NormalizedType{},
+				MarkdownDescription: "Public key as a serialised JWK, for verifiers to trust ahead of promotion",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the key was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the key status last changed",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Signing key identifier, also used as the `kid` of issued tokens",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *JwtSigningKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan JwtSigningKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Status.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state JwtSigningKeyResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Promoting another key to in_use moves this key to previously_used, which must not be reverted on the next plan
+		if state.Status.ValueString() == string(api.SigningKeyResponseStatusPreviouslyUsed) &&
+			plan.ProjectRef.Equal(state.ProjectRef) && plan.Algorithm.Equal(state.Algorithm) {
+			switch api.SigningKeyResponseStatus(plan.Status.ValueString()) {
+			case api.SigningKeyResponseStatusStandby, api.SigningKeyResponseStatusInUse:
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), state.Status)...)
+			}
+		}
+		return
+	}
+
+	// Only new keys are restricted in their initial status
+	switch api.CreateSigningKeyBodyStatus(plan.Status.ValueString()) {
+	case api.CreateSigningKeyBodyStatusStandby, api.CreateSigningKeyBodyStatusInUse:
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("status"), "Invalid Signing Key Status",
+			fmt.Sprintf("New signing keys must be created as standby or in_use, got: %s", plan.Status.ValueString()))
+	}
+}
+
+func (r *JwtSigningKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_ref": identityschema.StringAttribute{
+				Description:       "Project reference ID",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "Signing key ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *JwtSigningKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *JwtSigningKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data JwtSigningKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.V1CreateProjectSigningKeyWithResponse(ctx, data.ProjectRef.ValueString(), api.V1CreateProjectSigningKeyJSONRequestBody{
+		Algorithm: api.CreateSigningKeyBodyAlgorithm(data.Algorithm.ValueString()),
+		Status:    Ptr(api.CreateSigningKeyBodyStatus(data.Status.ValueString())),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create signing key, got error: %s", err))
+		return
+	}
+	if httpResp.JSON201 == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create signing key, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(setSigningKeyAttributes(&data, httpResp.JSON201)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created signing key")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, jwtSigningKeyIdentity(&data))...)
+}
+
+func (r *JwtSigningKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data JwtSigningKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readSigningKey(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read signing key")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, jwtSigningKeyIdentity(&data))...)
+}
+
+func (r *JwtSigningKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data JwtSigningKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the status can change in place
+	resp.Diagnostics.Append(updateSigningKeyStatus(ctx, &data, api.UpdateSigningKeyBodyStatus(data.Status.ValueString()), r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated signing key")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, jwtSigningKeyIdentity(&data))...)
+}
+
+func (r *JwtSigningKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data JwtSigningKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch api.SigningKeyResponseStatus(data.Status.ValueString()) {
+	case api.SigningKeyResponseStatusInUse:
+		// The API never removes the key signing current tokens, so only drop it from state
+		resp.Diagnostics.AddWarning("Signing Key In Use",
+			fmt.Sprintf("Signing key %s is in use and was removed from Terraform state without being deleted", data.Id.ValueString()))
+		return
+	case api.SigningKeyResponseStatusPreviouslyUsed:
+		// Tokens signed by a previously used key are still trusted until it is revoked
+		resp.Diagnostics.Append(updateSigningKeyStatus(ctx, &data, api.UpdateSigningKeyBodyStatusRevoked, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	keyId, diags := parseSigningKeyUUID(data.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.V1RemoveProjectSigningKeyWithResponse(ctx, data.ProjectRef.ValueString(), keyId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete signing key, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("signing key not found: %s", data.Id.ValueString()))
+		return
+	}
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete signing key, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted signing key")
+}
+
+func (r *JwtSigningKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity JwtSigningKeyResourceIdentityModel
+	if req.ID != "" {
+		parts := strings.SplitN(req.ID, "/", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected import ID in format 'project_ref/key_id', got: %s", req.ID),
+			)
+			return
+		}
+		identity.ProjectRef = types.StringValue(strings.TrimSpace(parts[0]))
+		identity.Id = types.StringValue(strings.TrimSpace(parts[1]))
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data := JwtSigningKeyResourceModel{
+		ProjectRef: identity.ProjectRef,
+		Id:         identity.Id,
+	}
+
+	found, diags := readSigningKey(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("Signing key %s does not exist in project %s", data.Id.ValueString(), data.ProjectRef.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, jwtSigningKeyIdentity(&data))...)
+}
+
+func setSigningKeyAttributes(data *JwtSigningKeyResourceModel, key *api.SigningKeyResponse) diag.Diagnostics {
+	var err error
+
+	data.Id = types.StringValue(key.Id.String())
+	data.Algorithm = types.StringValue(string(key.Algorithm))
+	data.Status = types.StringValue(string(key.Status))
+	data.CreatedAt = types.StringValue(key.CreatedAt.Format(time.RFC3339))
+	data.UpdatedAt = types.StringValue(key.UpdatedAt.Format(time.RFC3339))
+
+	if data.PublicJwk, err = nullableAnyToNormalized(key.PublicJwk); err != nil {
+		msg := fmt.Sprintf("Unable to parse public JWK, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return nil
+}
+
+func parseSigningKeyUUID(value string) (uuid.UUID, diag.Diagnostics) {
+	keyId, err := uuid.Parse(value)
+	if err != nil {
+		msg := fmt.Sprintf("Invalid signing key ID %q: %s", value, err)
+		return uuid.Nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return keyId, nil
+}
+
+func readSigningKey(ctx context.Context, data *JwtSigningKeyResourceModel, client Client) (bool, diag.Diagnostics) {
+	keyId, diags := parseSigningKeyUUID(data.Id.ValueString())
+	if diags.HasError() {
+		return false, diags
+	}
+
+	httpResp, err := client.V1GetProjectSigningKeyWithResponse(ctx, data.ProjectRef.ValueString(), keyId)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read signing key, got error: %s", err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("signing key not found: %s", data.Id.ValueString()))
+		return false, nil
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read signing key, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return true, setSigningKeyAttributes(data, httpResp.JSON200)
+}
+
+func updateSigningKeyStatus(ctx context.Context, data *JwtSigningKeyResourceModel, status api.UpdateSigningKeyBodyStatus, client Client) diag.Diagnostics {
+	keyId, diags := parseSigningKeyUUID(data.Id.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+
+	httpResp, err := client.V1UpdateProjectSigningKeyWithResponse(ctx, data.ProjectRef.ValueString(), keyId, api.V1UpdateProjectSigningKeyJSONRequestBody{
+		Status: status,
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to update signing key, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to update signing key, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return setSigningKeyAttributes(data, httpResp.JSON200)
+}
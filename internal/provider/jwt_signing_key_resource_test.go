@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+const (
+	testCurrentSigningKeyId = "0b6f7e3c-5c7a-4c0e-9b52-3f2b8f4c1a01"
+	testNextSigningKeyId    = "7d1e9a24-8f3b-4e6d-a1c5-92b0c4d7e802"
+)
+
+func testSigningKey(id, status string) map[string]any {
+	return map[string]any{
+		"id":         id,
+		"algorithm":  "ES256",
+		"status":     status,
+		"created_at": "2025-03-01T00:00:00Z",
+		"updated_at": "2025-03-01T00:00:00Z",
+		"public_jwk": map[string]any{
+			"kid": id,
+			"kty": "EC",
+			"crv": "P-256",
+			"alg": "ES256",
+			"x":   "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU",
+			"y":   "x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0",
+		},
+	}
+}
+
+func TestAccJwtSigningKeyResource(t *testing.T) {
+	currentApiPath := signingKeysApiPath + "/" + testCurrentSigningKeyId
+	nextApiPath := signingKeysApiPath + "/" + testNextSigningKeyId
+
+	defer gock.OffAll()
+	// Step 1: create the standby key before the key it replaces
+	gock.New(defaultApiEndpoint).
+		Post(signingKeysApiPath).
+		AddMatcher(matchJSONBodyField("status", "standby")).
+		Reply(http.StatusCreated).
+		JSON(testSigningKey(testNextSigningKeyId, "standby"))
+	gock.New(defaultApiEndpoint).
+		Post(signingKeysApiPath).
+		AddMatcher(matchJSONBodyField("status", "in_use")).
+		Reply(http.StatusCreated).
+		JSON(testSigningKey(testCurrentSigningKeyId, "in_use"))
+	gock.New(defaultApiEndpoint).
+		Get(nextApiPath).
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testNextSigningKeyId, "standby"))
+	gock.New(defaultApiEndpoint).
+		Get(currentApiPath).
+		Times(4).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "in_use"))
+	// Step 3: promote the standby key, then demote the current key
+	gock.New(defaultApiEndpoint).
+		Patch(nextApiPath).
+		AddMatcher(matchJSONBodyField("status", "in_use")).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testNextSigningKeyId, "in_use"))
+	gock.New(defaultApiEndpoint).
+		Patch(currentApiPath).
+		AddMatcher(matchJSONBodyField("status", "previously_used")).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "previously_used"))
+	gock.New(defaultApiEndpoint).
+		Get(nextApiPath).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testNextSigningKeyId, "in_use"))
+	gock.New(defaultApiEndpoint).
+		Get(currentApiPath).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "previously_used"))
+	// Delete revokes the previously used key, the in use key is only dropped from state
+	gock.New(defaultApiEndpoint).
+		Patch(currentApiPath).
+		AddMatcher(matchJSONBodyField("status", "revoked")).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "revoked"))
+	gock.New(defaultApiEndpoint).
+		Delete(currentApiPath).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "revoked"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.JwtSigningKeyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.current", "id", testCurrentSigningKeyId),
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.current", "status", "in_use"),
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.next", "id", testNextSigningKeyId),
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.next", "status", "standby"),
+					resource.TestCheckResourceAttrWith("supabase_jwt_signing_key.next", "public_jwk", func(value string) error {
+						if !regexp.MustCompile(`"kid":"` + testNextSigningKeyId + `"`).MatchString(value) {
+							return fmt.Errorf("unexpected public_jwk: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_jwt_signing_key.current",
+				ImportState:       true,
+				ImportStateId:     testProjectRef + "/" + testCurrentSigningKeyId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_jwt_signing_key" "current" {
+  project_ref = %[1]q
+  algorithm   = "ES256"
+  status      = "previously_used"
+
+  depends_on = [supabase_jwt_signing_key.next]
+}
+
+resource "supabase_jwt_signing_key" "next" {
+  project_ref = %[1]q
+  algorithm   = "ES256"
+  status      = "in_use"
+}
+`, testProjectRef),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.current", "status", "previously_used"),
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.next", "status", "in_use"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccJwtSigningKeyResource_Rotation(t *testing.T) {
+	currentApiPath := signingKeysApiPath + "/" + testCurrentSigningKeyId
+	nextApiPath := signingKeysApiPath + "/" + testNextSigningKeyId
+
+	defer gock.OffAll()
+	var promoted atomic.Bool
+	gock.New(defaultApiEndpoint).
+		Post(signingKeysApiPath).
+		AddMatcher(matchJSONBodyField("status", "standby")).
+		Reply(http.StatusCreated).
+		JSON(testSigningKey(testNextSigningKeyId, "standby"))
+	gock.New(defaultApiEndpoint).
+		Post(signingKeysApiPath).
+		AddMatcher(matchJSONBodyField("status", "in_use")).
+		Reply(http.StatusCreated).
+		JSON(testSigningKey(testCurrentSigningKeyId, "in_use"))
+	// Promoting the next key moves the current key to previously_used on the server
+	gock.New(defaultApiEndpoint).
+		Patch(nextApiPath).
+		AddMatcher(matchJSONBodyField("status", "in_use")).
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { promoted.Store(true); return true, nil }).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testNextSigningKeyId, "in_use"))
+	gock.New(defaultApiEndpoint).
+		Get(nextApiPath).
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return promoted.Load(), nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testNextSigningKeyId, "in_use"))
+	gock.New(defaultApiEndpoint).
+		Get(nextApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testNextSigningKeyId, "standby"))
+	gock.New(defaultApiEndpoint).
+		Get(currentApiPath).
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return promoted.Load(), nil }).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "previously_used"))
+	gock.New(defaultApiEndpoint).
+		Get(currentApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "in_use"))
+	// Delete revokes the previously used key, the in use key is only dropped from state
+	gock.New(defaultApiEndpoint).
+		Patch(currentApiPath).
+		AddMatcher(matchJSONBodyField("status", "revoked")).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "revoked"))
+	gock.New(defaultApiEndpoint).
+		Delete(currentApiPath).
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "revoked"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: examples.JwtSigningKeyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.current", "status", "in_use"),
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.next", "status", "standby"),
+				),
+			},
+			// Promote the next key without touching the current key's config
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_jwt_signing_key" "current" {
+  project_ref = %[1]q
+  algorithm   = "ES256"
+  status      = "in_use"
+
+  depends_on = [supabase_jwt_signing_key.next]
+}
+
+resource "supabase_jwt_signing_key" "next" {
+  project_ref = %[1]q
+  algorithm   = "ES256"
+  status      = "in_use"
+}
+`, testProjectRef),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.next", "status", "in_use"),
+				),
+			},
+			// The demoted key keeps its status instead of being promoted back
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.current", "status", "previously_used"),
+					resource.TestCheckResourceAttr("supabase_jwt_signing_key.next", "status", "in_use"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJwtSigningKeyResource_InvalidStatus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_jwt_signing_key" "test" {
+  project_ref = %q
+  algorithm   = "RS256"
+  status      = "revoked"
+}
+`, testProjectRef),
+				ExpectError: regexp.MustCompile(`Invalid Signing Key Status`),
+			},
+		},
+	})
+}
+
+func TestAccJwtSigningKeyResource_ImportIdentity(t *testing.T) {
+	currentApiPath := signingKeysApiPath + "/" + testCurrentSigningKeyId
+
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Post(signingKeysApiPath).
+		Reply(http.StatusCreated).
+		JSON(testSigningKey(testCurrentSigningKeyId, "in_use"))
+	gock.New(defaultApiEndpoint).
+		Get(currentApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testSigningKey(testCurrentSigningKeyId, "in_use"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_jwt_signing_key" "current" {
+  project_ref = %q
+  algorithm   = "ES256"
+  status      = "in_use"
+}
+`, testProjectRef),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("supabase_jwt_signing_key.current", map[string]knownvalue.Check{
+						"project_ref": knownvalue.StringExact(testProjectRef),
+						"id":          knownvalue.StringExact(testCurrentSigningKeyId),
+					}),
+				},
+			},
+			{
+				ResourceName:    "supabase_jwt_signing_key.current",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
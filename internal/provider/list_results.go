@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// listResults streams one result per item, stopping once Terraform stops
+// reading or the requested limit is reached. When full resources are requested,
+// each result starts out with every attribute null so it can be read into the
+// resource model before being populated by build.
+func listResults[T any](ctx context.Context, req list.ListRequest, items []T, build func(item T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			if req.IncludeResource {
+				nullResourceState(ctx, result.Resource)
+			}
+			build(item, &result)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// nullResourceState replaces a fully null resource with an object whose
+// attributes are all null, which can be read into a resource model.
+func nullResourceState(ctx context.Context, resource *tfsdk.Resource) {
+	objectType, ok := resource.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		return
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	resource.Raw = tftypes.NewValue(objectType, attributes)
+}
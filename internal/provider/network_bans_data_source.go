@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/supabase/cli/pkg/api"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -23,7 +22,7 @@ func NewNetworkBansDataSource() datasource.DataSource {
 
 // Defines the data source implementation.
 type NetworkBansDataSource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 // Describes the data source data model.
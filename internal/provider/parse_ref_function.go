@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseRefFunction{}
+
+func NewParseRefFunction() function.Function {
+	return &ParseRefFunction{}
+}
+
+// ParseRefFunction defines the function implementation.
+type ParseRefFunction struct{}
+
+func (f *ParseRefFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_ref"
+}
+
+func (f *ParseRefFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Extracts the project ref from a URL",
+		MarkdownDescription: "Extracts the project reference ID from a project API URL (`https://<project_ref>.supabase.co`), " +
+			"a database host (`db.<project_ref>.supabase.co`) or a Postgres connection string, including shared pooler connection strings.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "Project URL, database host or connection string",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rawUrl string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &rawUrl))
+	if resp.Error != nil {
+		return
+	}
+
+	ref, err := parseProjectRef(rawUrl)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ref))
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &PauseProjectAction{}
+	_ action.ActionWithConfigure = &PauseProjectAction{}
+)
+
+func NewPauseProjectAction() action.Action {
+	return &PauseProjectAction{}
+}
+
+// PauseProjectAction defines the action implementation.
+type PauseProjectAction struct {
+	client Client
+}
+
+// PauseProjectActionModel describes the action data model.
+type PauseProjectActionModel struct {
+	ProjectRef types.String   `tfsdk:"project_ref"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (a *PauseProjectAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pause_project"
+}
+
+func (a *PauseProjectAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pauses a project and waits until it is inactive.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *PauseProjectAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		a.client = client
+	}
+}
+
+func (a *PauseProjectAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data PauseProjectActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectRef := data.ProjectRef.ValueString()
+	httpResp, err := a.client.V1PauseAProjectWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to pause project, got error: %s", err)
+		resp.Diagnostics.AddError("Client Error", msg)
+		return
+	}
+	if httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to pause project, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		resp.Diagnostics.AddError("Client Error", msg)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for project %s to pause", projectRef)})
+	resp.Diagnostics.Append(waitForProjectPaused(ctx, projectRef, a.client, invokeTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "paused project")
+}
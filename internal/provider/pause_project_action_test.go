@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// testActionTrigger invokes the given action once when the trigger resource is created.
+func testActionTrigger(action string) string {
+	return `
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [` + action + `]
+    }
+  }
+}
+`
+}
+
+func TestAccPauseProjectAction(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Post(projectApiPath + "/pause").
+		Reply(http.StatusOK)
+	pausing := testProjectWithDatabase("staging")
+	pausing.Status = api.V1ProjectWithDatabaseResponseStatusPAUSING
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(pausing)
+	paused := testProjectWithDatabase("staging")
+	paused.Status = api.V1ProjectWithDatabaseResponseStatusINACTIVE
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(paused)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invoke testing
+			{
+				Config: examples.PauseProjectActionConfig + testActionTrigger("action.supabase_pause_project.staging"),
+			},
+		},
+	})
+}
+
+func TestAccPauseProjectAction_Failed(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Post(projectApiPath + "/pause").
+		Reply(http.StatusOK)
+	failed := testProjectWithDatabase("staging")
+	failed.Status = api.V1ProjectWithDatabaseResponseStatusPAUSEFAILED
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(failed)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      examples.PauseProjectActionConfig + testActionTrigger("action.supabase_pause_project.staging"),
+				ExpectError: regexp.MustCompile(`Project Not Paused`),
+			},
+		},
+	})
+}
+
+func TestAccPauseProjectAction_Timeout(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Post(projectApiPath + "/pause").
+		Reply(http.StatusOK)
+	pausing := testProjectWithDatabase("staging")
+	pausing.Status = api.V1ProjectWithDatabaseResponseStatusPAUSING
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(pausing)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+action "supabase_pause_project" "staging" {
+  config {
+    project_ref = "` + testProjectRef + `"
+
+    timeouts {
+      invoke = "1s"
+    }
+  }
+}
+` + testActionTrigger("action.supabase_pause_project.staging"),
+				ExpectError: regexp.MustCompile(`did not pause within timeout`),
+			},
+		},
+	})
+}
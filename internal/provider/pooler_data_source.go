@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -24,7 +25,7 @@ func NewPoolerDataSource() datasource.DataSource {
 
 // PoolerDataSource defines the data source implementation.
 type PoolerDataSource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 // PoolerDataSourceModel describes the data source data model.
@@ -85,10 +86,10 @@ func (d *PoolerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	url := map[string]string{}
+	urls := map[string]string{}
 	for _, pooler := range *httpResp.JSON200 {
 		if pooler.DatabaseType == api.SupavisorConfigResponseDatabaseTypePRIMARY {
-			url[string(pooler.PoolMode)] = pooler.ConnectionString
+			urls[string(pooler.PoolMode)] = poolerConnectionString(projectRef.ValueString(), pooler)
 		}
 	}
 
@@ -97,5 +98,22 @@ func (d *PoolerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	tflog.Trace(ctx, "read a data source")
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), url)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), urls)...)
+}
+
+// poolerConnectionString returns the connection string of a pooler, building it
+// from the pooler host like the connection_string function when it is missing.
+func poolerConnectionString(projectRef string, pooler api.SupavisorConfigResponse) string {
+	if pooler.ConnectionString != "" || pooler.DbHost == "" {
+		return pooler.ConnectionString
+	}
+	user := pooler.DbUser
+	if user == "" {
+		user = poolerUser(defaultDatabaseUser, projectRef)
+	}
+	database := pooler.DbName
+	if database == "" {
+		database = defaultDatabaseName
+	}
+	return postgresUrl(url.User(user), pooler.DbHost, pooler.DbPort, database)
 }
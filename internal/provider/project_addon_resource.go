@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ProjectAddonResource{}
+	_ resource.ResourceWithImportState = &ProjectAddonResource{}
+	_ resource.ResourceWithIdentity    = &ProjectAddonResource{}
+	_ resource.ResourceWithModifyPlan  = &ProjectAddonResource{}
+)
+
+func NewProjectAddonResource() resource.Resource {
+	return &ProjectAddonResource{}
+}
+
+// ProjectAddonResource defines the resource implementation.
+type ProjectAddonResource struct {
+	client Client
+}
+
+// ProjectAddonResourceModel describes the resource data model.
+type ProjectAddonResourceModel struct {
+	ProjectRef   types.String   `tfsdk:"project_ref"`
+	AddonType    types.String   `tfsdk:"addon_type"`
+	AddonVariant types.String   `tfsdk:"addon_variant"`
+	VariantName  types.String   `tfsdk:"variant_name"`
+	Id           types.String   `tfsdk:"id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ProjectAddonResourceIdentityModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+	AddonType  types.String `tfsdk:"addon_type"`
+}
+
+func projectAddonIdentity(data *ProjectAddonResourceModel) ProjectAddonResourceIdentityModel {
+	return ProjectAddonResourceIdentityModel{
+		ProjectRef: data.ProjectRef,
+		AddonType:  data.AddonType,
+	}
+}
+
+func (r *ProjectAddonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_addon"
+}
+
+func (r *ProjectAddonResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project add-on resource. Manages a billing add-on such as IPv4, point-in-time recovery or a custom domain. " +
+			"The type and variant are validated at plan time against the add-ons available to the project.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"addon_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the add-on, e.g. `ipv4`, `pitr` or `custom_domain`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"addon_variant": schema.StringAttribute{
+				MarkdownDescription: "Variant of the add-on, e.g. `ipv4_default`, `pitr_7` or `cd_default`",
+				Required:            true,
+			},
+			"variant_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the selected variant",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Add-on identifier in the format `project_ref/addon_type`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectAddonResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ProjectAddonResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectRef.IsUnknown() || plan.AddonType.IsUnknown() || plan.AddonVariant.IsUnknown() {
+		return
+	}
+
+	// Only look up the available add-ons when the variant is about to change
+	if !req.State.Raw.IsNull() {
+		var state ProjectAddonResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || plan.AddonVariant.Equal(state.AddonVariant) {
+			return
+		}
+	}
+
+	addons, diags := listProjectAddons(ctx, plan.ProjectRef.ValueString(), r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, diags = findAvailableAddonVariant(addons, plan.AddonType.ValueString(), plan.AddonVariant.ValueString())
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ProjectAddonResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_ref": identityschema.StringAttribute{
+				Description:       "Project reference ID",
+				RequiredForImport: true,
+			},
+			"addon_type": identityschema.StringAttribute{
+				Description:       "Add-on type",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ProjectAddonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *ProjectAddonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectAddonResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(applyProjectAddon(ctx, &data, r.client, createTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created project addon")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectAddonIdentity(&data))...)
+}
+
+func (r *ProjectAddonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectAddonResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readProjectAddon(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read project addon")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectAddonIdentity(&data))...)
+}
+
+func (r *ProjectAddonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectAddonResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AddonVariant.Equal(state.AddonVariant) {
+		// Timeout-only changes do not require a Supabase API call.
+		state.Timeouts = plan.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, projectAddonIdentity(&state))...)
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(applyProjectAddon(ctx, &plan, r.client, updateTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated project addon")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectAddonIdentity(&plan))...)
+}
+
+func (r *ProjectAddonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectAddonResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(removeProjectAddon(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForProjectActive(ctx, data.ProjectRef.ValueString(), r.client, deleteTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "deleted project addon")
+}
+
+func (r *ProjectAddonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity ProjectAddonResourceIdentityModel
+	if req.ID != "" {
+		parts := strings.SplitN(req.ID, "/", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected import ID in format 'project_ref/addon_type', got: %s", req.ID),
+			)
+			return
+		}
+		identity.ProjectRef = types.StringValue(strings.TrimSpace(parts[0]))
+		identity.AddonType = types.StringValue(strings.TrimSpace(parts[1]))
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data := ProjectAddonResourceModel{
+		ProjectRef: identity.ProjectRef,
+		AddonType:  identity.AddonType,
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	found, diags := readProjectAddon(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("Add-on %s is not enabled on project %s", data.AddonType.ValueString(), data.ProjectRef.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectAddonIdentity(&data))...)
+}
+
+func listProjectAddons(ctx context.Context, projectRef string, client Client) (*api.ListProjectAddonsResponse, diag.Diagnostics) {
+	httpResp, err := client.V1ListProjectAddonsWithResponse(ctx, projectRef)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read project addons, got error: %s", err)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read project addons, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	return httpResp.JSON200, nil
+}
+
+// addonVariantId decodes the variant id union returned by the addons endpoint.
+func addonVariantId(id json.Marshaler) (string, error) {
+	raw, err := id.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	var variant string
+	if err := json.Unmarshal(raw, &variant); err != nil {
+		return "", err
+	}
+	return variant, nil
+}
+
+// findAvailableAddonVariant looks up the requested variant in the available addons list,
+// returning its display name or an error listing the accepted values.
+func findAvailableAddonVariant(addons *api.ListProjectAddonsResponse, addonType, addonVariant string) (string, diag.Diagnostics) {
+	addonTypes := make([]string, 0, len(addons.AvailableAddons))
+	for _, addon := range addons.AvailableAddons {
+		addonTypes = append(addonTypes, string(addon.Type))
+		if string(addon.Type) != addonType {
+			continue
+		}
+
+		variants := make([]string, 0, len(addon.Variants))
+		for _, v := range addon.Variants {
+			id, err := addonVariantId(v.Id)
+			if err != nil {
+				msg := fmt.Sprintf("Unable to read %s addon variants, got error: %s", addonType, err)
+				return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+			}
+			if id == addonVariant {
+				return v.Name, nil
+			}
+			variants = append(variants, id)
+		}
+
+		return "", diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+			path.Root("addon_variant"),
+			"Invalid Addon Variant",
+			fmt.Sprintf("Variant %q is not available for addon type %s, expected one of: %s", addonVariant, addonType, strings.Join(variants, ", ")),
+		)}
+	}
+
+	return "", diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+		path.Root("addon_type"),
+		"Invalid Addon Type",
+		fmt.Sprintf("Addon type %q is not available for this project, expected one of: %s", addonType, strings.Join(addonTypes, ", ")),
+	)}
+}
+
+func applyProjectAddon(ctx context.Context, data *ProjectAddonResourceModel, client Client, timeout time.Duration) diag.Diagnostics {
+	projectRef := data.ProjectRef.ValueString()
+
+	addons, diags := listProjectAddons(ctx, projectRef, client)
+	if diags.HasError() {
+		return diags
+	}
+	name, diags := findAvailableAddonVariant(addons, data.AddonType.ValueString(), data.AddonVariant.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+
+	var variant api.ApplyProjectAddonBody_AddonVariant
+	raw, err := json.Marshal(data.AddonVariant.ValueString())
+	if err == nil {
+		err = variant.UnmarshalJSON(raw)
+	}
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Internal Error",
+			fmt.Sprintf("Failed to configure addon variant: %s", err),
+		)}
+	}
+	body := api.V1ApplyProjectAddonJSONRequestBody{
+		AddonType:    api.ApplyProjectAddonBodyAddonType(data.AddonType.ValueString()),
+		AddonVariant: variant,
+	}
+
+	httpResp, err := client.V1ApplyProjectAddonWithResponse(ctx, projectRef, body)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to apply project addon, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to apply project addon, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	// Wait for project to settle after the addon is provisioned
+	if diags := waitForProjectActive(ctx, projectRef, client, timeout); diags.HasError() {
+		return diags
+	}
+
+	data.VariantName = types.StringValue(name)
+	data.Id = types.StringValue(projectRef + "/" + data.AddonType.ValueString())
+	return nil
+}
+
+func readProjectAddon(ctx context.Context, data *ProjectAddonResourceModel, client Client) (bool, diag.Diagnostics) {
+	addons, diags := listProjectAddons(ctx, data.ProjectRef.ValueString(), client)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, addon := range addons.SelectedAddons {
+		if string(addon.Type) != data.AddonType.ValueString() {
+			continue
+		}
+
+		variant, err := addonVariantId(addon.Variant.Id)
+		if err != nil {
+			msg := fmt.Sprintf("Unable to read %s addon, got error: %s", addon.Type, err)
+			return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+		}
+
+		data.AddonVariant = types.StringValue(variant)
+		data.VariantName = types.StringValue(addon.Variant.Name)
+		data.Id = types.StringValue(data.ProjectRef.ValueString() + "/" + data.AddonType.ValueString())
+		return true, nil
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("project addon not found: %s", data.AddonType.ValueString()))
+	return false, nil
+}
+
+func removeProjectAddon(ctx context.Context, data *ProjectAddonResourceModel, client Client) diag.Diagnostics {
+	httpResp, err := client.RemoveProjectAddonWithResponse(ctx, data.ProjectRef.ValueString(), data.AddonVariant.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to remove project addon, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("project addon not found: %s", data.AddonVariant.ValueString()))
+		return nil
+	}
+	if httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to remove project addon, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return nil
+}
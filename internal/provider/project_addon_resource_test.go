@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func testPitrVariant(days int) map[string]any {
+	return map[string]any{
+		"id":    fmt.Sprintf("pitr_%d", days),
+		"name":  fmt.Sprintf("%d days", days),
+		"price": map[string]any{},
+	}
+}
+
+// testProjectAddons returns a list addons response with IPv4 and PITR available.
+func testProjectAddons(selected ...map[string]any) map[string]any {
+	return map[string]any{
+		"selected_addons": selected,
+		"available_addons": []map[string]any{
+			{
+				"type": "ipv4",
+				"name": "Dedicated IPv4 address",
+				"variants": []map[string]any{
+					{"id": "ipv4_default", "name": "Dedicated IPv4 address", "price": map[string]any{}},
+				},
+			},
+			{
+				"type":     "pitr",
+				"name":     "Point in time recovery",
+				"variants": []map[string]any{testPitrVariant(7), testPitrVariant(14), testPitrVariant(28)},
+			},
+		},
+	}
+}
+
+func testSelectedPitr(days int) map[string]any {
+	return map[string]any{"type": "pitr", "variant": testPitrVariant(days)}
+}
+
+func projectAddonResourceConfig(variant string) string {
+	return fmt.Sprintf(`
+resource "supabase_project_addon" "test" {
+  project_ref   = %q
+  addon_type    = "pitr"
+  addon_variant = %q
+}
+`, testProjectRef, variant)
+}
+
+func TestAccProjectAddonResource(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	projectRef := fake.addProject("foo")
+	config := func(variant string) string {
+		return fmt.Sprintf(`
+resource "supabase_project_addon" "test" {
+  project_ref   = %q
+  addon_type    = "pitr"
+  addon_variant = %q
+}
+`, projectRef, variant)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { fake.preCheck(t) },
+		ProtoV6ProviderFactories: fake.providerFactories(),
+		CheckDestroy: func(_ *terraform.State) error {
+			if addons := fake.projectAddons(projectRef); len(addons) > 0 {
+				return fmt.Errorf("expected add-ons to be removed, got %v", addons)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("pitr_7"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project_addon.test", "id", projectRef+"/pitr"),
+					resource.TestCheckResourceAttr("supabase_project_addon.test", "addon_variant", "pitr_7"),
+					resource.TestCheckResourceAttr("supabase_project_addon.test", "variant_name", "7 days"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_project_addon.test",
+				ImportState:       true,
+				ImportStateId:     projectRef + "/pitr",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+			},
+			// Update and Read testing
+			{
+				Config: config("pitr_14"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project_addon.test", "addon_variant", "pitr_14"),
+					resource.TestCheckResourceAttr("supabase_project_addon.test", "variant_name", "14 days"),
+				),
+			},
+			// Invalid variants are rejected at plan time
+			{
+				Config:      config("pitr_99"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`pitr_7, pitr_14, pitr_28`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestRemoveProjectAddon(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	projectRef := fake.addProject("foo")
+	client := fake.client(t)
+
+	data := ProjectAddonResourceModel{
+		ProjectRef:   types.StringValue(projectRef),
+		AddonType:    types.StringValue("ipv4"),
+		AddonVariant: types.StringValue("ipv4_default"),
+	}
+	if diags := applyProjectAddon(t.Context(), &data, client, time.Minute); diags.HasError() {
+		t.Fatalf("Failed to apply addon: %v", diags)
+	}
+	if got := fake.projectAddons(projectRef)["ipv4"]; got != "ipv4_default" {
+		t.Fatalf("Expected ipv4_default to be selected, got %q", got)
+	}
+
+	if diags := removeProjectAddon(t.Context(), &data, client); diags.HasError() {
+		t.Fatalf("Failed to remove addon: %v", diags)
+	}
+	if addons := fake.projectAddons(projectRef); len(addons) > 0 {
+		t.Errorf("Expected no add-ons after removal, got %v", addons)
+	}
+
+	// Removing an add-on that is already gone is not an error
+	if diags := removeProjectAddon(t.Context(), &data, client); diags.HasError() {
+		t.Errorf("Expected removal of a missing addon to succeed, got %v", diags)
+	}
+}
+
+func TestAccProjectAddonResource_InvalidVariant(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(billingApiPath).
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(testProjectAddons())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      projectAddonResourceConfig("pitr_99"),
+				ExpectError: regexp.MustCompile(`pitr_7, pitr_14, pitr_28`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "supabase_project_addon" "test" {
+  project_ref   = %q
+  addon_type    = "log_drain"
+  addon_variant = "default"
+}
+`, testProjectRef),
+				ExpectError: regexp.MustCompile(`Invalid Addon Type`),
+			},
+		},
+	})
+}
+
+func TestAccProjectAddonResource_Example(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Patch(billingApiPath).
+		Times(2).
+		Reply(http.StatusOK)
+	// Two add-ons are validated when planning and again when applying
+	gock.New(defaultApiEndpoint).
+		Get(billingApiPath).
+		Times(8).
+		Reply(http.StatusOK).
+		JSON(testProjectAddons(testSelectedPitr(7), map[string]any{
+			"type":    "ipv4",
+			"variant": map[string]any{"id": "ipv4_default", "name": "Dedicated IPv4 address", "price": map[string]any{}},
+		}))
+	gock.New(defaultApiEndpoint).
+		Delete(billingApiPath).
+		Times(2).
+		Reply(http.StatusOK)
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Times(4).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: examples.ProjectAddonResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project_addon.ipv4", "addon_variant", "ipv4_default"),
+					resource.TestCheckResourceAttr("supabase_project_addon.pitr", "addon_variant", "pitr_7"),
+				),
+			},
+		},
+	})
+}
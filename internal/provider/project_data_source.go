@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &ProjectDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ProjectDataSource{}
+)
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+// ProjectDataSource defines the data source implementation.
+type ProjectDataSource struct {
+	client Client
+}
+
+// ProjectDataSourceModel describes the data source data model.
+type ProjectDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
+	Name            types.String `tfsdk:"name"`
+	Region          types.String `tfsdk:"region"`
+	InstanceSize    types.String `tfsdk:"instance_size"`
+	Status          types.String `tfsdk:"status"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	DatabaseHost    types.String `tfsdk:"database_host"`
+	DatabaseVersion types.String `tfsdk:"database_version"`
+	ApiUrl          types.String `tfsdk:"api_url"`
+	PoolerHost      types.String `tfsdk:"pooler_host"`
+}
+
+func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project data source. Looks up a single project either by its reference or by organization and name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Project identifier. Conflicts with `name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization slug. Required when looking up a project by `name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the project. Conflicts with `id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region where the project is located",
+				Computed:            true,
+			},
+			"instance_size": schema.StringAttribute{
+				MarkdownDescription: "Instance size of the project",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the project",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp of the project",
+				Computed:            true,
+			},
+			"database_host": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the project database",
+				Computed:            true,
+			},
+			"database_version": schema.StringAttribute{
+				MarkdownDescription: "Postgres version of the project database",
+				Computed:            true,
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the project API, e.g. `https://<project_ref>.supabase.co`",
+				Computed:            true,
+			},
+			"pooler_host": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the connection pooler for the primary database. Null when the pooler config cannot be read",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProjectDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("organization_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		d.client = client
+	}
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectRef := data.Id.ValueString()
+	if data.Id.IsNull() {
+		ref, diags := findProjectRefByName(ctx, d.client, data.OrganizationId.ValueString(), data.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		projectRef = ref
+	}
+
+	project := ProjectResourceModel{Id: types.StringValue(projectRef)}
+	resp.Diagnostics.Append(readProject(ctx, &project, d.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// readProject leaves the model untouched when the project does not exist
+	if project.Status.IsNull() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project %s not found", projectRef))
+		return
+	}
+
+	data = ProjectDataSourceModel{
+		Id:              project.Id,
+		OrganizationId:  project.OrganizationId,
+		Name:            project.Name,
+		Region:          project.Region,
+		InstanceSize:    project.InstanceSize,
+		Status:          project.Status,
+		CreatedAt:       project.CreatedAt,
+		DatabaseHost:    project.DatabaseHost,
+		DatabaseVersion: project.DatabaseVersion,
+		ApiUrl:          project.ApiUrl,
+		PoolerHost:      project.PoolerHost,
+	}
+
+	tflog.Trace(ctx, "read project data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findProjectRefByName(ctx context.Context, client Client, organization, name string) (string, diag.Diagnostics) {
+	httpResp, err := client.V1ListAllProjectsWithResponse(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list projects, got error: %s", err)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list projects, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	projectRef := ""
+	for _, project := range *httpResp.JSON200 {
+		if !projectInOrganization(&project, organization) || project.Name != name {
+			continue
+		}
+		if projectRef != "" {
+			msg := fmt.Sprintf("Found multiple projects named %q in organization %s, look up the project by id instead", name, organization)
+			return "", diag.Diagnostics{diag.NewErrorDiagnostic("Ambiguous Project Name", msg)}
+		}
+		projectRef = project.Id
+	}
+
+	if projectRef == "" {
+		msg := fmt.Sprintf("Project %q not found in organization %s", name, organization)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	return projectRef, nil
+}
+
+// projectInOrganization accepts both the organization slug and the deprecated organization id.
+func projectInOrganization(project *api.V1ProjectWithDatabaseResponse, organization string) bool {
+	return project.OrganizationSlug == organization || project.OrganizationId == organization
+}
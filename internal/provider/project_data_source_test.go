@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	mockReadProject(3)
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: examples.ProjectDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_project.production", "id", testProjectRef),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "name", "foo"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "organization_id", "continued-brown-smelt"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "instance_size", "micro"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "status", "ACTIVE_HEALTHY"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "api_url", "https://"+testProjectRef+".supabase.co"),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "pooler_host", "aws-0-us-east-1.pooler.supabase.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectDataSource_ByName(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	other := testProjectWithDatabase("foo")
+	other.Id = "abcdefghijklmnopqrst"
+	other.OrganizationId = "other-org"
+	mockReadProject(3)
+	gock.New(defaultApiEndpoint).
+		Get(projectsApiPath).
+		Times(3).
+		Reply(http.StatusOK).
+		JSON([]api.V1ProjectWithDatabaseResponse{other, testProjectWithDatabase("foo")})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "supabase_project" "production" {
+  organization_id = "continued-brown-smelt"
+  name            = "foo"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_project.production", "id", testProjectRef),
+					resource.TestCheckResourceAttr("data.supabase_project.production", "region", "us-east-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectDataSource_InvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "supabase_project" "production" {
+  id   = "mayuaycdtijbctgqbycg"
+  name = "foo"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+data "supabase_project" "production" {
+  name = "foo"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
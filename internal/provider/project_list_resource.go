@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &ProjectListResource{}
+	_ list.ListResourceWithConfigure = &ProjectListResource{}
+)
+
+func NewProjectListResource() list.ListResource {
+	return &ProjectListResource{}
+}
+
+// ProjectListResource defines the list resource implementation.
+type ProjectListResource struct {
+	client Client
+}
+
+// ProjectListResourceModel describes the list resource config model.
+type ProjectListResourceModel struct {
+	OrganizationId types.String `tfsdk:"organization_id"`
+}
+
+func (r *ProjectListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the projects accessible to the configured access token",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Only list projects in this organization, identified by slug or id",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *ProjectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ProjectListResourceModel
+
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	httpResp, err := r.client.V1ListAllProjectsWithResponse(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list projects, got error: %s", err)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list projects, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+
+	projects := make([]api.V1ProjectWithDatabaseResponse, 0, len(*httpResp.JSON200))
+	for _, project := range *httpResp.JSON200 {
+		if config.OrganizationId.IsNull() || projectInOrganization(&project, config.OrganizationId.ValueString()) {
+			projects = append(projects, project)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, projects, func(project api.V1ProjectWithDatabaseResponse, result *list.ListResult) {
+		data := ProjectResourceModel{
+			Id: types.StringValue(project.Ref),
+		}
+		result.DisplayName = project.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, projectIdentity(&data))...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		data.Id = types.StringValue(project.Ref)
+		if diags := readProject(ctx, &data, r.client); diags.HasError() {
+			result.Diagnostics.Append(diags...)
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccProjectListResource(t *testing.T) {
+	defer gock.OffAll()
+
+	gock.New(defaultApiEndpoint).
+		Get(projectsApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.V1ProjectWithDatabaseResponse{
+			{
+				Id:               testProjectRef,
+				Ref:              testProjectRef,
+				Name:             "foo",
+				OrganizationId:   "continued-brown-smelt",
+				OrganizationSlug: "continued-brown-smelt",
+			},
+			{
+				Id:               testBranchRef,
+				Ref:              testBranchRef,
+				Name:             "bar",
+				OrganizationId:   "other-org",
+				OrganizationSlug: "other-org",
+			},
+		})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Query: true,
+				Config: testAccQueryProviderConfig + examples.ProjectListResourceConfig + `
+list "supabase_project" "unfiltered" {
+  provider = supabase
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("supabase_project.all", 1),
+					querycheck.ExpectIdentity("supabase_project.all", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(testProjectRef),
+					}),
+					querycheck.ExpectLength("supabase_project.unfiltered", 2),
+				},
+			},
+		},
+	})
+}
@@ -5,21 +5,27 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
+	"math/big"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/supabase/cli/pkg/api"
@@ -27,29 +33,114 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ProjectResource{}
-	_ resource.ResourceWithImportState = &ProjectResource{}
+	_ resource.Resource                     = &ProjectResource{}
+	_ resource.ResourceWithImportState      = &ProjectResource{}
+	_ resource.ResourceWithConfigValidators = &ProjectResource{}
+	_ resource.ResourceWithIdentity         = &ProjectResource{}
 )
 
+const (
+	defaultGeneratedPasswordLength  = 32
+	defaultGeneratedPasswordCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// plans a new generated database password when no password is configured,
+// either on create or whenever password_rotation_keepers change. Imported
+// projects keep their current password until the keepers change.
+type generatedPasswordPlanModifier struct{}
+
+func (m generatedPasswordPlanModifier) Description(_ context.Context) string {
+	return "Generates a new database password on create and whenever password_rotation_keepers change."
+}
+
+func (m generatedPasswordPlanModifier) MarkdownDescription(_ context.Context) string {
+	return "Generates a new database password on create and whenever `password_rotation_keepers` change."
+}
+
+func (m generatedPasswordPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var password, passwordWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("database_password"), &password)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("database_password_wo"), &passwordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An explicitly configured password is never replaced by a generated one
+	if !password.IsNull() || !passwordWo.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	var planKeepers, stateKeepers types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("password_rotation_keepers"), &planKeepers)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_rotation_keepers"), &stateKeepers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported projects have neither a generated password nor keepers in state.
+	// Generating one here would silently rotate the live database password.
+	if req.StateValue.IsNull() && stateKeepers.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	if !planKeepers.Equal(stateKeepers) {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
 }
 
 // ProjectResource defines the resource implementation.
 type ProjectResource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 // ProjectResourceModel describes the resource data model.
 type ProjectResourceModel struct {
-	OrganizationId       types.String   `tfsdk:"organization_id"`
-	Name                 types.String   `tfsdk:"name"`
-	DatabasePassword     types.String   `tfsdk:"database_password"`
-	Region               types.String   `tfsdk:"region"`
-	InstanceSize         types.String   `tfsdk:"instance_size"`
-	Id                   types.String   `tfsdk:"id"`
-	LegacyApiKeysEnabled types.Bool     `tfsdk:"legacy_api_keys_enabled"`
-	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	OrganizationId            types.String   `tfsdk:"organization_id"`
+	Name                      types.String   `tfsdk:"name"`
+	DatabasePassword          types.String   `tfsdk:"database_password"`
+	DatabasePasswordWo        types.String   `tfsdk:"database_password_wo"`
+	DatabasePasswordWoVersion types.Int64    `tfsdk:"database_password_wo_version"`
+	GeneratedPasswordLength   types.Int64    `tfsdk:"generated_password_length"`
+	GeneratedPasswordCharset  types.String   `tfsdk:"generated_password_charset"`
+	PasswordRotationKeepers   types.Map      `tfsdk:"password_rotation_keepers"`
+	GeneratedDatabasePassword types.String   `tfsdk:"generated_database_password"`
+	Region                    types.String   `tfsdk:"region"`
+	InstanceSize              types.String   `tfsdk:"instance_size"`
+	Id                        types.String   `tfsdk:"id"`
+	LegacyApiKeysEnabled      types.Bool     `tfsdk:"legacy_api_keys_enabled"`
+	Status                    types.String   `tfsdk:"status"`
+	CreatedAt                 types.String   `tfsdk:"created_at"`
+	DatabaseHost              types.String   `tfsdk:"database_host"`
+	DatabaseVersion           types.String   `tfsdk:"database_version"`
+	ApiUrl                    types.String   `tfsdk:"api_url"`
+	PoolerHost                types.String   `tfsdk:"pooler_host"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ProjectResourceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func projectIdentity(data *ProjectResourceModel) ProjectResourceIdentityModel {
+	return ProjectResourceIdentityModel{Id: data.Id}
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +149,9 @@ func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Project resource",
+		MarkdownDescription: "Project resource.\n\n" +
+			"~> **NOTE:** Creating a project from another project's backup is not supported, because the Management API does not expose the restore to new project flow. " +
+			"Use `supabase_project_restore` to restore a project in place.",
 
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -76,10 +169,57 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:            true,
 			},
 			"database_password": schema.StringAttribute{
-				MarkdownDescription: "Password for the project database",
-				Required:            true,
-				Sensitive:           true,
-				Validators:          []validator.String{stringvalidator.LengthAtLeast(4)},
+				MarkdownDescription: "Password for the project database. Conflicts with `database_password_wo`. " +
+					"When neither is set, a password is generated and exposed as `generated_database_password`.",
+				Optional:   true,
+				Sensitive:  true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(4)},
+			},
+			"database_password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only password for the project database, which is never stored in Terraform state. " +
+					"Requires Terraform 1.11 or later. Increment `database_password_wo_version` to apply a new password to an existing project.",
+				Optional:   true,
+				Sensitive:  true,
+				WriteOnly:  true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(4)},
+			},
+			"database_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `database_password_wo`. Changing this value updates the database password. " +
+					"Removing it together with `database_password_wo` keeps the current password.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("database_password_wo")),
+				},
+			},
+			"generated_password_length": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Length of the generated database password. Defaults to `%d`.", defaultGeneratedPasswordLength),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(16, 128),
+				},
+			},
+			"generated_password_charset": schema.StringAttribute{
+				MarkdownDescription: "Characters to draw the generated database password from. Defaults to upper and lower case letters and digits, " +
+					"which keeps the password safe to embed in connection strings.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(10),
+				},
+			},
+			"password_rotation_keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, rotates the generated database password. " +
+					"Has no effect when a password is configured explicitly.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"generated_database_password": schema.StringAttribute{
+				MarkdownDescription: "Database password generated by the provider when neither `database_password` nor `database_password_wo` is set. " +
+					"Imported projects keep their current password until `password_rotation_keepers` change.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					generatedPasswordPlanModifier{},
+				},
 			},
 			"region": schema.StringAttribute{
 				MarkdownDescription: "Region where the project is located",
@@ -134,10 +274,72 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the project",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp of the project",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_host": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the project database",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_version": schema.StringAttribute{
+				MarkdownDescription: "Postgres version of the project database",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the project API, e.g. `https://<project_ref>.supabase.co`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pooler_host": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the connection pooler for the primary database. Null when the pooler config cannot be read",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
+func (r *ProjectResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Project reference ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ProjectResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("database_password"),
+			path.MatchRoot("database_password_wo"),
+		),
+	}
+}
+
 func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
 		r.client = client
@@ -159,8 +361,14 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	password, diags := projectDatabasePassword(ctx, req.Config, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "create project")
-	resp.Diagnostics.Append(createProject(ctx, &data, r.client, createTimeout)...)
+	resp.Diagnostics.Append(createProject(ctx, &data, password, r.client, createTimeout)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -180,6 +388,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectIdentity(&data))...)
 }
 
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -200,6 +409,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectIdentity(&data))...)
 }
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -221,8 +431,23 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if !plan.Name.Equal(state.Name) {
 		resp.Diagnostics.Append(updateName(ctx, &plan, r.client)...)
 	}
-	if !plan.DatabasePassword.Equal(state.DatabasePassword) {
-		resp.Diagnostics.Append(updateDatabasePassword(ctx, &plan, r.client)...)
+	// Each password source only rotates the database password on its own signal
+	switch {
+	case !plan.DatabasePassword.IsNull() && !plan.DatabasePassword.Equal(state.DatabasePassword):
+		resp.Diagnostics.Append(updateDatabasePassword(ctx, &plan, plan.DatabasePassword.ValueString(), r.client)...)
+	case !plan.DatabasePasswordWoVersion.IsNull() && !plan.DatabasePasswordWoVersion.Equal(state.DatabasePasswordWoVersion):
+		// Removing the version together with the write-only password keeps the current password
+		var passwordWo types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("database_password_wo"), &passwordWo)...)
+		if !passwordWo.IsNull() {
+			resp.Diagnostics.Append(updateDatabasePassword(ctx, &plan, passwordWo.ValueString(), r.client)...)
+		}
+	case plan.GeneratedDatabasePassword.IsUnknown():
+		password, diags := generateDatabasePassword(&plan)
+		resp.Diagnostics.Append(diags...)
+		if !diags.HasError() {
+			resp.Diagnostics.Append(updateDatabasePassword(ctx, &plan, password, r.client)...)
+		}
 	}
 	if !plan.Region.Equal(state.Region) {
 		resp.Diagnostics.AddAttributeError(path.Root("region"), "Client Error", "Update is not supported for this attribute")
@@ -245,6 +470,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectIdentity(&plan))...)
 }
 
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -268,10 +494,67 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// projectDatabasePassword returns the configured database password, or generates
+// one when none is configured. Write-only values are only available from the
+// configuration, never from plan or state.
+func projectDatabasePassword(ctx context.Context, config tfsdk.Config, data *ProjectResourceModel) (string, diag.Diagnostics) {
+	if !data.DatabasePassword.IsNull() {
+		return data.DatabasePassword.ValueString(), nil
+	}
+
+	var password types.String
+	if diags := config.GetAttribute(ctx, path.Root("database_password_wo"), &password); diags.HasError() {
+		return "", diags
+	}
+	if !password.IsNull() {
+		return password.ValueString(), nil
+	}
+
+	return generateDatabasePassword(data)
 }
 
-func createProject(ctx context.Context, data *ProjectResourceModel, client *api.ClientWithResponses, timeout time.Duration) diag.Diagnostics {
+// generateDatabasePassword generates a new database password and records it as
+// the generated_database_password of data.
+func generateDatabasePassword(data *ProjectResourceModel) (string, diag.Diagnostics) {
+	length := defaultGeneratedPasswordLength
+	if !data.GeneratedPasswordLength.IsNull() {
+		length = int(data.GeneratedPasswordLength.ValueInt64())
+	}
+	charset := defaultGeneratedPasswordCharset
+	if !data.GeneratedPasswordCharset.IsNull() {
+		charset = data.GeneratedPasswordCharset.ValueString()
+	}
+
+	generated, err := generatePassword(length, charset)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to generate database password, got error: %s", err)
+		return "", diag.Diagnostics{diag.NewErrorDiagnostic("Internal Error", msg)}
+	}
+	data.GeneratedDatabasePassword = types.StringValue(generated)
+
+	return generated, nil
+}
+
+// generatePassword draws length characters uniformly from charset using a
+// cryptographically secure random source.
+func generatePassword(length int, charset string) (string, error) {
+	chars := []rune(charset)
+	size := big.NewInt(int64(len(chars)))
+	password := make([]rune, length)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", err
+		}
+		password[i] = chars[n.Int64()]
+	}
+	return string(password), nil
+}
+
+func createProject(ctx context.Context, data *ProjectResourceModel, password string, client Client, timeout time.Duration) diag.Diagnostics {
 	regionSelection := api.V1CreateProjectBodyRegionSelection0{
 		Type: api.Specific,
 		Code: api.V1CreateProjectBodyRegionSelection0Code(data.Region.ValueString()),
@@ -287,7 +570,7 @@ func createProject(ctx context.Context, data *ProjectResourceModel, client *api.
 	body := api.V1CreateAProjectJSONRequestBody{
 		OrganizationSlug: data.OrganizationId.ValueString(),
 		Name:             data.Name.ValueString(),
-		DbPass:           data.DatabasePassword.ValueString(),
+		DbPass:           password,
 		RegionSelection:  &region,
 	}
 	if !data.InstanceSize.IsUnknown() && !data.InstanceSize.IsNull() {
@@ -315,7 +598,7 @@ func createProject(ctx context.Context, data *ProjectResourceModel, client *api.
 	return nil
 }
 
-func readProject(ctx context.Context, data *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readProject(ctx context.Context, data *ProjectResourceModel, client Client) diag.Diagnostics {
 	projectResp, err := client.V1GetProjectWithResponse(ctx, data.Id.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read project, got error: %s", err)
@@ -332,9 +615,7 @@ func readProject(ctx context.Context, data *ProjectResourceModel, client *api.Cl
 	}
 
 	project := projectResp.JSON200
-	data.OrganizationId = types.StringValue(project.OrganizationId)
-	data.Name = types.StringValue(project.Name)
-	data.Region = types.StringValue(project.Region)
+	setProjectAttributes(data, project)
 	data.InstanceSize = types.StringNull()
 
 	legacyKeysResp, err := client.V1GetProjectLegacyApiKeysWithResponse(ctx, project.Id)
@@ -381,10 +662,53 @@ func readProject(ctx context.Context, data *ProjectResourceModel, client *api.Cl
 		break
 	}
 
+	data.PoolerHost = readPoolerHost(ctx, project.Id, client)
 	return nil
 }
 
-func deleteProject(ctx context.Context, data *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+// readPoolerHost returns the host of the primary database pooler. The pooler is
+// informational, so failures are logged and yield a null host instead of failing
+// every refresh of the project.
+func readPoolerHost(ctx context.Context, projectRef string, client Client) types.String {
+	poolerResp, err := client.V1GetPoolerConfigWithResponse(ctx, projectRef)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read project pooler config", map[string]any{
+			"project_ref": projectRef,
+			"error":       err.Error(),
+		})
+		return types.StringNull()
+	}
+
+	if poolerResp.JSON200 == nil {
+		tflog.Warn(ctx, "Unable to read project pooler config", map[string]any{
+			"project_ref": projectRef,
+			"status":      poolerResp.StatusCode(),
+			"body":        string(poolerResp.Body),
+		})
+		return types.StringNull()
+	}
+
+	for _, pooler := range *poolerResp.JSON200 {
+		if pooler.DatabaseType == api.SupavisorConfigResponseDatabaseTypePRIMARY {
+			return types.StringValue(pooler.DbHost)
+		}
+	}
+	return types.StringNull()
+}
+
+// setProjectAttributes copies the fields returned by the projects endpoint into the model.
+func setProjectAttributes(data *ProjectResourceModel, project *api.V1ProjectWithDatabaseResponse) {
+	data.OrganizationId = types.StringValue(project.OrganizationId)
+	data.Name = types.StringValue(project.Name)
+	data.Region = types.StringValue(project.Region)
+	data.Status = types.StringValue(string(project.Status))
+	data.CreatedAt = types.StringValue(project.CreatedAt)
+	data.DatabaseHost = types.StringValue(project.Database.Host)
+	data.DatabaseVersion = types.StringValue(project.Database.Version)
+	data.ApiUrl = types.StringValue(projectApiUrl(project.Id))
+}
+
+func deleteProject(ctx context.Context, data *ProjectResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1DeleteAProjectWithResponse(ctx, data.Id.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to delete project, got error: %s", err)
@@ -404,7 +728,7 @@ func deleteProject(ctx context.Context, data *ProjectResourceModel, client *api.
 	return nil
 }
 
-func updateInstanceSize(ctx context.Context, plan *ProjectResourceModel, client *api.ClientWithResponses, timeout time.Duration) diag.Diagnostics {
+func updateInstanceSize(ctx context.Context, plan *ProjectResourceModel, client Client, timeout time.Duration) diag.Diagnostics {
 	addon := api.ApplyProjectAddonBody_AddonVariant{}
 	variant := api.ApplyProjectAddonBodyAddonVariant0("ci_" + plan.InstanceSize.ValueString())
 	if err := addon.FromApplyProjectAddonBodyAddonVariant0(variant); err != nil {
@@ -437,7 +761,7 @@ func updateInstanceSize(ctx context.Context, plan *ProjectResourceModel, client
 	return nil
 }
 
-func updateLegacyAPIKeysEnabled(ctx context.Context, plan *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateLegacyAPIKeysEnabled(ctx context.Context, plan *ProjectResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1UpdateProjectLegacyApiKeysWithResponse(ctx, plan.Id.ValueString(), &api.V1UpdateProjectLegacyApiKeysParams{
 		Enabled: plan.LegacyApiKeysEnabled.ValueBool(),
 	})
@@ -453,7 +777,7 @@ func updateLegacyAPIKeysEnabled(ctx context.Context, plan *ProjectResourceModel,
 	return nil
 }
 
-func updateName(ctx context.Context, plan *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateName(ctx context.Context, plan *ProjectResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1UpdateAProjectWithResponse(ctx, plan.Id.ValueString(), api.V1UpdateProjectBody{
 		Name: plan.Name.ValueString(),
 	})
@@ -470,9 +794,9 @@ func updateName(ctx context.Context, plan *ProjectResourceModel, client *api.Cli
 	return nil
 }
 
-func updateDatabasePassword(ctx context.Context, plan *ProjectResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateDatabasePassword(ctx context.Context, plan *ProjectResourceModel, password string, client Client) diag.Diagnostics {
 	httpResp, err := client.V1UpdateDatabasePasswordWithResponse(ctx, plan.Id.ValueString(), api.V1UpdatePasswordBody{
-		Password: plan.DatabasePassword.ValueString(),
+		Password: password,
 	})
 	if err != nil {
 		msg := fmt.Sprintf("Unable to update database password, got error: %s", err)
@@ -4,13 +4,24 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/supabase/cli/pkg/api"
 	"github.com/supabase/terraform-provider-supabase/examples"
 	"gopkg.in/h2non/gock.v1"
@@ -47,13 +58,7 @@ func TestAccProjectResource(t *testing.T) {
 	gock.New(defaultApiEndpoint).
 		Get(projectApiPath).
 		Reply(http.StatusOK).
-		JSON(api.V1ProjectWithDatabaseResponse{
-			Id:             testProjectRef,
-			Name:           "foo",
-			OrganizationId: "continued-brown-smelt",
-			Region:         "us-east-1",
-			Status:         api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY,
-		})
+		JSON(testProjectWithDatabase("foo"))
 	gock.New(defaultApiEndpoint).
 		Get(legacyApiKeysApiPath).
 		Reply(http.StatusOK).
@@ -74,17 +79,15 @@ func TestAccProjectResource(t *testing.T) {
 			},
 			"available_addons": []map[string]any{},
 		})
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
 	// Terraform refresh after create
 	gock.New(defaultApiEndpoint).
 		Get(projectApiPath).
 		Reply(http.StatusOK).
-		JSON(api.V1ProjectWithDatabaseResponse{
-			Id:             testProjectRef,
-			Name:           "foo",
-			OrganizationId: "continued-brown-smelt",
-			Region:         "us-east-1",
-			Status:         api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY,
-		})
+		JSON(testProjectWithDatabase("foo"))
 	gock.New(defaultApiEndpoint).
 		Get(legacyApiKeysApiPath).
 		Reply(http.StatusOK).
@@ -105,6 +108,10 @@ func TestAccProjectResource(t *testing.T) {
 			},
 			"available_addons": []map[string]any{},
 		})
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
 	// Step 2: update instance size
 	gock.New(defaultApiEndpoint).
 		Get(projectApiPath).
@@ -136,6 +143,10 @@ func TestAccProjectResource(t *testing.T) {
 			},
 			"available_addons": []map[string]any{},
 		})
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
 	gock.New(defaultApiEndpoint).
 		Patch(projectApiPath).
 		Reply(http.StatusOK)
@@ -187,6 +198,10 @@ func TestAccProjectResource(t *testing.T) {
 			},
 			"available_addons": []map[string]any{},
 		})
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
 	// Step 3: toggle legacy API keys
 	// Plan refresh read
 	gock.New(defaultApiEndpoint).
@@ -219,6 +234,10 @@ func TestAccProjectResource(t *testing.T) {
 			},
 			"available_addons": []map[string]any{},
 		})
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
 	// Enable legacy API keys
 	gock.New(defaultApiEndpoint).
 		Put(legacyApiKeysApiPath).
@@ -255,6 +274,10 @@ func TestAccProjectResource(t *testing.T) {
 			},
 			"available_addons": []map[string]any{},
 		})
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
 	// Step 4: import state
 	gock.New(defaultApiEndpoint).
 		Get(projectApiPath).
@@ -286,6 +309,10 @@ func TestAccProjectResource(t *testing.T) {
 			},
 			"available_addons": []map[string]any{},
 		})
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
 	// Step 5: delete
 	gock.New(defaultApiEndpoint).
 		Delete(projectApiPath).
@@ -309,6 +336,12 @@ func TestAccProjectResource(t *testing.T) {
 					resource.TestCheckResourceAttr("supabase_project.test", "instance_size", "micro"),
 					resource.TestCheckResourceAttr("supabase_project.test", "database_password", "barbaz"),
 					resource.TestCheckResourceAttr("supabase_project.test", "legacy_api_keys_enabled", "false"),
+					resource.TestCheckResourceAttr("supabase_project.test", "status", "ACTIVE_HEALTHY"),
+					resource.TestCheckResourceAttr("supabase_project.test", "created_at", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("supabase_project.test", "database_host", "db."+testProjectRef+".supabase.co"),
+					resource.TestCheckResourceAttr("supabase_project.test", "database_version", "15.8.1.085"),
+					resource.TestCheckResourceAttr("supabase_project.test", "api_url", "https://"+testProjectRef+".supabase.co"),
+					resource.TestCheckResourceAttr("supabase_project.test", "pooler_host", "aws-0-us-east-1.pooler.supabase.com"),
 				),
 			},
 			// Update instance size testing
@@ -360,6 +393,62 @@ func TestAccProjectResource(t *testing.T) {
 	})
 }
 
+func testProjectWithDatabase(name string) api.V1ProjectWithDatabaseResponse {
+	project := api.V1ProjectWithDatabaseResponse{
+		Id:             testProjectRef,
+		Name:           name,
+		OrganizationId: "continued-brown-smelt",
+		Region:         "us-east-1",
+		Status:         api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY,
+		CreatedAt:      "2024-01-01T00:00:00Z",
+	}
+	project.Database.Host = "db." + testProjectRef + ".supabase.co"
+	project.Database.Version = "15.8.1.085"
+	return project
+}
+
+var testPoolerConfig = []api.SupavisorConfigResponse{{
+	DatabaseType: api.SupavisorConfigResponseDatabaseTypePRIMARY,
+	DbHost:       "aws-0-us-east-1.pooler.supabase.com",
+	PoolMode:     api.SupavisorConfigResponsePoolModeTransaction,
+}}
+
+func mockReadProject(times int) {
+	// Register nested paths first, gock matches paths as prefixes
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Times(times).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
+	gock.New(defaultApiEndpoint).
+		Get(billingApiPath).
+		Times(times).
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"selected_addons": []map[string]any{
+				{
+					"type": "compute_instance",
+					"variant": map[string]any{
+						"id":    api.ListProjectAddonsResponseAvailableAddonsVariantsId0CiMicro,
+						"name":  "Micro",
+						"price": map[string]any{},
+					},
+				},
+			},
+			"available_addons": []map[string]any{},
+		})
+	gock.New(defaultApiEndpoint).
+		Get(legacyApiKeysApiPath).
+		Times(times).
+		Reply(http.StatusOK).
+		JSON(map[string]any{"enabled": false})
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Times(times).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+}
+
 func projectResourceConfig(p ProjectResourceModel) string {
 	rv := fmt.Sprintf(`resource "supabase_project" "test" {
   organization_id         = "%s"
@@ -447,6 +536,10 @@ func TestAccProjectResource_Timeouts(t *testing.T) {
 			},
 			"available_addons": []map[string]any{},
 		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/config/database/pooler").
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
 	// Post-apply refresh: read project, legacy keys, addons
 	gock.New("https://api.supabase.com").
 		Get("/v1/projects/mayuaycdtijbctgqbycg").
@@ -478,6 +571,10 @@ func TestAccProjectResource_Timeouts(t *testing.T) {
 			},
 			"available_addons": []map[string]any{},
 		})
+	gock.New("https://api.supabase.com").
+		Get("/v1/projects/mayuaycdtijbctgqbycg/config/database/pooler").
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
 	// Delete
 	gock.New("https://api.supabase.com").
 		Delete("/v1/projects/mayuaycdtijbctgqbycg").
@@ -509,3 +606,493 @@ func TestAccProjectResource_Timeouts(t *testing.T) {
 		},
 	})
 }
+
+func TestAccProjectResource_WriteOnlyPassword(t *testing.T) {
+	defer gock.OffAll()
+	// Create
+	gock.New(defaultApiEndpoint).
+		Post(projectsApiPath).
+		AddMatcher(matchJSONBodyField("db_pass", "barbaz")).
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   testProjectRef,
+			Name: "foo",
+		})
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+	// readProject after create, refresh after apply and plan refresh before update
+	mockReadProject(3)
+	// Update password when the version is bumped
+	gock.New(defaultApiEndpoint).
+		Patch(dbPasswordApiPath).
+		AddMatcher(matchJSONBodyField("password", "barbaznew")).
+		Reply(http.StatusOK)
+	// Refresh after update
+	mockReadProject(1)
+	// Delete
+	gock.New(defaultApiEndpoint).
+		Delete(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{Ref: testProjectRef})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: projectResourceConfigWriteOnly("barbaz", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "id", testProjectRef),
+					resource.TestCheckNoResourceAttr("supabase_project.test", "database_password"),
+					resource.TestCheckNoResourceAttr("supabase_project.test", "database_password_wo"),
+					resource.TestCheckResourceAttr("supabase_project.test", "database_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: projectResourceConfigWriteOnly("barbaznew", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("supabase_project.test", "database_password_wo"),
+					resource.TestCheckResourceAttr("supabase_project.test", "database_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_WriteOnlyPasswordRemoved(t *testing.T) {
+	defer gock.OffAll()
+	// Create
+	gock.New(defaultApiEndpoint).
+		Post(projectsApiPath).
+		AddMatcher(matchJSONBodyField("db_pass", "barbaz")).
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   testProjectRef,
+			Name: "foo",
+		})
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+	// readProject after create, refresh after apply, plan refresh before update,
+	// refresh after update and plan refresh of the unchanged config. No password
+	// update is mocked, so any attempt to rotate the password fails the test.
+	mockReadProject(5)
+	// Delete
+	gock.New(defaultApiEndpoint).
+		Delete(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{Ref: testProjectRef})
+
+	const config = `
+resource "supabase_project" "test" {
+  organization_id = "continued-brown-smelt"
+  name            = "foo"
+  region          = "us-east-1"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: projectResourceConfigWriteOnly("barbaz", 1),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("supabase_project.test", "database_password_wo_version"),
+					resource.TestCheckNoResourceAttr("supabase_project.test", "generated_database_password"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_PasswordValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "supabase_project" "test" {
+  organization_id      = "continued-brown-smelt"
+  name                 = "foo"
+  database_password    = "barbaz"
+  database_password_wo = "barbaz"
+  region               = "us-east-1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+resource "supabase_project" "test" {
+  organization_id              = "continued-brown-smelt"
+  name                         = "foo"
+  database_password            = "barbaz"
+  database_password_wo_version = 1
+  region                       = "us-east-1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_GeneratedPassword(t *testing.T) {
+	defer gock.OffAll()
+	// Create with a generated password
+	gock.New(defaultApiEndpoint).
+		Post(projectsApiPath).
+		AddMatcher(matchJSONBodyFieldLength("db_pass", 40)).
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   testProjectRef,
+			Name: "foo",
+		})
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+	// readProject after create, refresh after apply and plan refresh before update
+	mockReadProject(3)
+	// Rotate password when the keepers change
+	gock.New(defaultApiEndpoint).
+		Patch(dbPasswordApiPath).
+		AddMatcher(matchJSONBodyFieldLength("password", 40)).
+		Reply(http.StatusOK)
+	// Refresh after update and plan refresh of the unchanged config
+	mockReadProject(2)
+	// Delete
+	gock.New(defaultApiEndpoint).
+		Delete(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{Ref: testProjectRef})
+
+	comparePassword := statecheck.CompareValue(compare.ValuesDiffer())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: projectResourceConfigGeneratedPassword("1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					comparePassword.AddStateValue("supabase_project.test", tfjsonpath.New("generated_database_password")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("supabase_project.test", "database_password"),
+					resource.TestCheckResourceAttrWith("supabase_project.test", "generated_database_password", checkPasswordLength(40)),
+				),
+			},
+			{
+				Config: projectResourceConfigGeneratedPassword("2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					comparePassword.AddStateValue("supabase_project.test", tfjsonpath.New("generated_database_password")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("supabase_project.test", "generated_database_password", checkPasswordLength(40)),
+				),
+			},
+			{
+				Config:   projectResourceConfigGeneratedPassword("2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_GeneratedToWriteOnlyPassword(t *testing.T) {
+	defer gock.OffAll()
+	// Create with a generated password
+	gock.New(defaultApiEndpoint).
+		Post(projectsApiPath).
+		AddMatcher(matchJSONBodyFieldLength("db_pass", 40)).
+		Reply(http.StatusCreated).
+		JSON(api.V1ProjectResponse{
+			Id:   testProjectRef,
+			Name: "foo",
+		})
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+	// readProject after create, refresh after apply and plan refresh before update
+	mockReadProject(3)
+	// Only the write-only password is sent, no new password is generated
+	gock.New(defaultApiEndpoint).
+		Patch(dbPasswordApiPath).
+		AddMatcher(matchJSONBodyField("password", "barbaz")).
+		Reply(http.StatusOK)
+	// Refresh after update
+	mockReadProject(1)
+	// Delete
+	gock.New(defaultApiEndpoint).
+		Delete(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(api.V1ProjectRefResponse{Ref: testProjectRef})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: projectResourceConfigGeneratedPassword("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("supabase_project.test", "generated_database_password", checkPasswordLength(40)),
+				),
+			},
+			{
+				Config: projectResourceConfigWriteOnly("barbaz", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("supabase_project.test", "generated_database_password"),
+					resource.TestCheckResourceAttr("supabase_project.test", "database_password_wo_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestGeneratePassword(t *testing.T) {
+	charset := "abcdefghij"
+	password, err := generatePassword(64, charset)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(password) != 64 {
+		t.Fatalf("expected password of length 64, got %d", len(password))
+	}
+	for _, c := range password {
+		if !strings.ContainsRune(charset, c) {
+			t.Fatalf("unexpected character %q in generated password", c)
+		}
+	}
+
+	other, err := generatePassword(64, charset)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if password == other {
+		t.Fatal("expected generated passwords to differ")
+	}
+}
+
+func checkPasswordLength(length int) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		if len(value) != length {
+			return fmt.Errorf("expected password of length %d, got %d", length, len(value))
+		}
+		return nil
+	}
+}
+
+// matchJSONBodyFieldLength matches requests whose JSON body sets field to a string of the given length.
+func matchJSONBodyFieldLength(field string, length int) gock.MatchFunc {
+	return func(req *http.Request, _ *gock.Request) (bool, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return false, err
+		}
+		req.Body = io.NopCloser(bytes.NewBuffer(body))
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return false, err
+		}
+		value, ok := payload[field].(string)
+		return ok && len(value) == length, nil
+	}
+}
+
+// matchJSONBodyField matches requests whose JSON body sets field to value.
+func matchJSONBodyField(field, value string) gock.MatchFunc {
+	return func(req *http.Request, _ *gock.Request) (bool, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return false, err
+		}
+		req.Body = io.NopCloser(bytes.NewBuffer(body))
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return false, err
+		}
+		return payload[field] == value, nil
+	}
+}
+
+func projectResourceConfigWriteOnly(password string, version int) string {
+	return fmt.Sprintf(`
+resource "supabase_project" "test" {
+  organization_id              = "continued-brown-smelt"
+  name                         = "foo"
+  database_password_wo         = "%s"
+  database_password_wo_version = %d
+  region                       = "us-east-1"
+}
+`, password, version)
+}
+
+func projectResourceConfigGeneratedPassword(rotation string) string {
+	return fmt.Sprintf(`
+resource "supabase_project" "test" {
+  organization_id           = "continued-brown-smelt"
+  name                      = "foo"
+  region                    = "us-east-1"
+  generated_password_length = 40
+
+  password_rotation_keepers = {
+    rotation = "%s"
+  }
+}
+`, rotation)
+}
+
+func TestAccProjectResource_FakeAPI(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { fake.preCheck(t) },
+		ProtoV6ProviderFactories: fake.providerFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			for _, rs := range s.RootModule().Resources {
+				if status := fake.projectStatus(rs.Primary.ID); status != "" {
+					return fmt.Errorf("project %s still exists with status %s", rs.Primary.ID, status)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create waits for the project to come up
+			{
+				Config: projectResourceConfig(ProjectResourceModel{
+					OrganizationId:   types.StringValue("continued-brown-smelt"),
+					Name:             types.StringValue("foo"),
+					DatabasePassword: types.StringValue("barbaz"),
+					Region:           types.StringValue("eu-west-1"),
+					InstanceSize:     types.StringValue("micro"),
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("supabase_project.test", "id"),
+					resource.TestCheckResourceAttr("supabase_project.test", "name", "foo"),
+					resource.TestCheckResourceAttr("supabase_project.test", "instance_size", "micro"),
+					resource.TestCheckResourceAttr("supabase_project.test", "status", "ACTIVE_HEALTHY"),
+					resource.TestCheckResourceAttr("supabase_project.test", "pooler_host", "aws-0-eu-west-1.pooler.supabase.com"),
+				),
+			},
+			// Update waits for the resize to finish
+			{
+				Config: projectResourceConfig(ProjectResourceModel{
+					OrganizationId:       types.StringValue("continued-brown-smelt"),
+					Name:                 types.StringValue("bar"),
+					DatabasePassword:     types.StringValue("barbaz"),
+					Region:               types.StringValue("eu-west-1"),
+					InstanceSize:         types.StringValue("small"),
+					LegacyApiKeysEnabled: types.BoolValue(false),
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project.test", "name", "bar"),
+					resource.TestCheckResourceAttr("supabase_project.test", "instance_size", "small"),
+					resource.TestCheckResourceAttr("supabase_project.test", "legacy_api_keys_enabled", "false"),
+					resource.TestCheckResourceAttr("supabase_project.test", "status", "ACTIVE_HEALTHY"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+
+				// database_password is not refreshed from the API
+				ImportStateVerifyIgnore: []string{"database_password"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestReadPoolerHost_FailureIsNotFatal(t *testing.T) {
+	defer gock.OffAll()
+	gock.InterceptClient(http.DefaultClient)
+	defer gock.RestoreClient(http.DefaultClient)
+
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusServiceUnavailable).
+		JSON(map[string]string{"message": "Pooler unavailable"})
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
+
+	client, err := newClient(defaultApiEndpoint)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if host := readPoolerHost(t.Context(), testProjectRef, client); !host.IsNull() {
+		t.Errorf("Expected null pooler host on failure, got %s", host)
+	}
+	if host := readPoolerHost(t.Context(), testProjectRef, client); host.ValueString() != "aws-0-us-east-1.pooler.supabase.com" {
+		t.Errorf("Expected primary pooler host, got %s", host)
+	}
+}
+
+func TestAccProjectResource_ImportKeepsPassword(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	projectRef := fake.addProject("foo")
+	password := fake.projectPassword(projectRef)
+	config := `
+resource "supabase_project" "test" {
+  organization_id = "continued-brown-smelt"
+  name            = "foo"
+  region          = "us-east-1"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { fake.preCheck(t) },
+		ProtoV6ProviderFactories: fake.providerFactories(),
+		Steps: []resource.TestStep{
+			// Import an existing project without a password in config
+			{
+				Config:             config,
+				ResourceName:       "supabase_project.test",
+				ImportState:        true,
+				ImportStateId:      projectRef,
+				ImportStatePersist: true,
+			},
+			// The first apply after import must not rotate the password
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("supabase_project.test", tfjsonpath.New("generated_database_password"), knownvalue.Null()),
+					},
+				},
+				Check: func(_ *terraform.State) error {
+					if got := fake.projectPassword(projectRef); got != password {
+						return fmt.Errorf("expected database password to be unchanged after import, got %q", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
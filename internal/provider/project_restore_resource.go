@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &ProjectRestoreResource{}
+	_ resource.ResourceWithConfigValidators = &ProjectRestoreResource{}
+	_ resource.ResourceWithModifyPlan       = &ProjectRestoreResource{}
+)
+
+// The project can report ACTIVE_HEALTHY for a short while after a restore is requested.
+const restoreStartTimeout = 2 * time.Minute
+
+const (
+	restoreStatusPending = "RESTORE_STATUS_PENDING"
+	restoreStatusStarted = "RESTORE_STATUS_STARTED"
+)
+
+func NewProjectRestoreResource() resource.Resource {
+	return &ProjectRestoreResource{}
+}
+
+// ProjectRestoreResource defines the resource implementation.
+type ProjectRestoreResource struct {
+	client Client
+}
+
+// ProjectRestoreResourceModel describes the resource data model.
+type ProjectRestoreResourceModel struct {
+	ProjectRef             types.String   `tfsdk:"project_ref"`
+	BackupId               types.String   `tfsdk:"backup_id"`
+	RecoveryTimeTarget     types.String   `tfsdk:"recovery_time_target"`
+	RecoveryTimeTargetUnix types.Int64    `tfsdk:"recovery_time_target_unix"`
+	Id                     types.String   `tfsdk:"id"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ProjectRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_restore"
+}
+
+func (r *ProjectRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project restore resource. Restores a project's database with point in time recovery when the resource is created, " +
+			"either to the time a physical backup was taken or to an arbitrary point in time. Requires point in time recovery to be enabled on the project. " +
+			"Changing any argument triggers a new restore. Destroying this resource does not undo the restore.\n\n" +
+			"~> **NOTE:** Restores always happen in place. Cloning a project from another project's backup into a new project is not supported, " +
+			"because the Management API does not expose the restore to new project flow.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of a completed physical backup, as returned in `inserted_at` by the `supabase_backups` data source. " +
+					"The project is recovered to that time with point in time recovery. Conflicts with `recovery_time_target`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recovery_time_target": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp to restore to with point in time recovery. Must fall within the available backup window. Conflicts with `backup_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recovery_time_target_unix": schema.Int64Attribute{
+				MarkdownDescription: "Unix timestamp the project was restored to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Restore identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectRestoreResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("backup_id"),
+			path.MatchRoot("recovery_time_target"),
+		),
+	}
+}
+
+func (r *ProjectRestoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only validate restores that are about to be triggered
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ProjectRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ProjectRef.IsUnknown() || plan.BackupId.IsUnknown() || plan.RecoveryTimeTarget.IsUnknown() {
+		return
+	}
+
+	target, diags := resolveRecoveryTimeTarget(ctx, &plan, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("recovery_time_target_unix"), target)...)
+}
+
+func (r *ProjectRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *ProjectRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectRestoreResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(restoreProject(ctx, &data, r.client, createTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "restored project")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectRestoreResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A restore is a one-off operation, there is nothing to refresh.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectRestoreResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every configurable attribute requires replacement, only timeouts can change here.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectRestoreResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Simply fallthrough since a restore cannot be reverted.
+}
+
+// resolveRecoveryTimeTarget validates the requested restore against the project's backups
+// and returns the unix timestamp to recover to.
+func resolveRecoveryTimeTarget(ctx context.Context, data *ProjectRestoreResourceModel, client Client) (int64, diag.Diagnostics) {
+	var target time.Time
+	if !data.RecoveryTimeTarget.IsNull() {
+		parsed, err := time.Parse(time.RFC3339, data.RecoveryTimeTarget.ValueString())
+		if err != nil {
+			return 0, diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root("recovery_time_target"),
+				"Invalid Recovery Time Target",
+				fmt.Sprintf("Expected an RFC 3339 timestamp, got error: %s", err),
+			)}
+		}
+		target = parsed
+	}
+
+	httpResp, err := client.V1ListAllBackupsWithResponse(ctx, data.ProjectRef.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list backups, got error: %s", err)
+		return 0, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list backups, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return 0, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	backups := httpResp.JSON200
+
+	// The API only restores through point in time recovery, backups included
+	if !backups.PitrEnabled {
+		attribute := path.Root("recovery_time_target")
+		if !data.BackupId.IsNull() {
+			attribute = path.Root("backup_id")
+		}
+		return 0, diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+			attribute,
+			"Point In Time Recovery Disabled",
+			fmt.Sprintf("Project %s does not have point in time recovery enabled", data.ProjectRef.ValueString()),
+		)}
+	}
+
+	if !data.BackupId.IsNull() {
+		var available []string
+		for _, backup := range backups.Backups {
+			if !backup.IsPhysicalBackup || backup.Status != api.V1BackupsResponseBackupsStatusCOMPLETED {
+				continue
+			}
+			if backup.InsertedAt == data.BackupId.ValueString() {
+				insertedAt, err := time.Parse(time.RFC3339, backup.InsertedAt)
+				if err != nil {
+					msg := fmt.Sprintf("Unable to parse backup timestamp, got error: %s", err)
+					return 0, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+				}
+				return insertedAt.Unix(), nil
+			}
+			available = append(available, backup.InsertedAt)
+		}
+		return 0, diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+			path.Root("backup_id"),
+			"Invalid Backup",
+			fmt.Sprintf("No completed physical backup %s found for project %s, available backups: %s",
+				data.BackupId.ValueString(), data.ProjectRef.ValueString(), strings.Join(available, ", ")),
+		)}
+	}
+
+	window := backups.PhysicalBackupData
+	earliest, latest := time.Time{}, time.Now()
+	if window.EarliestPhysicalBackupDateUnix != nil {
+		earliest = time.Unix(int64(*window.EarliestPhysicalBackupDateUnix), 0)
+	}
+	if window.LatestPhysicalBackupDateUnix != nil {
+		latest = time.Unix(int64(*window.LatestPhysicalBackupDateUnix), 0)
+	}
+	if target.Before(earliest) || target.After(latest) {
+		return 0, diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+			path.Root("recovery_time_target"),
+			"Invalid Recovery Time Target",
+			fmt.Sprintf("Recovery time target %s is outside the available backup window from %s to %s",
+				target.UTC().Format(time.RFC3339), earliest.UTC().Format(time.RFC3339), latest.UTC().Format(time.RFC3339)),
+		)}
+	}
+
+	return target.Unix(), nil
+}
+
+func restoreProject(ctx context.Context, data *ProjectRestoreResourceModel, client Client, timeout time.Duration) diag.Diagnostics {
+	projectRef := data.ProjectRef.ValueString()
+
+	if data.RecoveryTimeTargetUnix.IsUnknown() || data.RecoveryTimeTargetUnix.IsNull() {
+		target, diags := resolveRecoveryTimeTarget(ctx, data, client)
+		if diags.HasError() {
+			return diags
+		}
+		data.RecoveryTimeTargetUnix = types.Int64Value(target)
+	}
+
+	httpResp, err := client.V1RestorePitrBackupWithResponse(ctx, projectRef, api.V1RestorePitrBackupJSONRequestBody{
+		RecoveryTimeTargetUnix: data.RecoveryTimeTargetUnix.ValueInt64(),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to restore project, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusCreated {
+		msg := fmt.Sprintf("Unable to restore project, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	waitForRestoreStarted(ctx, projectRef, client)
+
+	// Wait for project to leave RESTORING
+	if diags := waitForProjectActive(ctx, projectRef, client, timeout); diags.HasError() {
+		return diags
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%d", projectRef, data.RecoveryTimeTargetUnix.ValueInt64()))
+	return nil
+}
+
+// waitForRestoreStarted waits for the project to leave ACTIVE_HEALTHY so that waiting
+// for it to become active again does not return before the restore has begun.
+func waitForRestoreStarted(ctx context.Context, projectRef string, client Client) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{restoreStatusPending},
+		Target:  []string{restoreStatusStarted},
+		Refresh: func() (any, string, error) {
+			httpResp, err := client.V1GetProjectWithResponse(ctx, projectRef)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get project status: %w", err)
+			}
+			if httpResp.JSON200 == nil {
+				return nil, "", fmt.Errorf("unexpected status %d: %s", httpResp.StatusCode(), httpResp.Body)
+			}
+			if httpResp.JSON200.Status == api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY {
+				return nil, restoreStatusPending, nil
+			}
+			return httpResp.JSON200, restoreStatusStarted, nil
+		},
+		Timeout: restoreStartTimeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Restore of project %s did not report as started: %s", projectRef, err))
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// testBackups returns a backups response with a PITR window from 2025-02-01 to 2025-03-08.
+func testBackups(pitrEnabled bool) map[string]any {
+	return map[string]any{
+		"region":       "us-east-1",
+		"pitr_enabled": pitrEnabled,
+		"walg_enabled": true,
+		"backups": []map[string]any{
+			{"inserted_at": "2025-03-01T00:00:00Z", "is_physical_backup": true, "status": "COMPLETED"},
+			{"inserted_at": "2025-03-02T00:00:00Z", "is_physical_backup": true, "status": "FAILED"},
+		},
+		"physical_backup_data": map[string]any{
+			"earliest_physical_backup_date_unix": 1738368000,
+			"latest_physical_backup_date_unix":   1741392000,
+		},
+	}
+}
+
+func matchRecoveryTimeTarget(target int64) gock.MatchFunc {
+	return func(req *http.Request, _ *gock.Request) (bool, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return false, err
+		}
+		req.Body = io.NopCloser(bytes.NewBuffer(body))
+		var payload api.V1RestorePitrBody
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return false, err
+		}
+		return payload.RecoveryTimeTargetUnix == target, nil
+	}
+}
+
+func TestAccProjectRestoreResource(t *testing.T) {
+	defer gock.OffAll()
+	// Register nested paths first, gock matches paths as prefixes
+	gock.New(defaultApiEndpoint).
+		Post(restorePitrApiPath).
+		AddMatcher(matchRecoveryTimeTarget(1740830400)).
+		Reply(http.StatusCreated)
+	gock.New(defaultApiEndpoint).
+		Get(backupsApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testBackups(true))
+	restoring := testProjectWithDatabase("foo")
+	restoring.Status = api.V1ProjectWithDatabaseResponseStatusRESTORING
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(restoring)
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: examples.ProjectRestoreResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_project_restore.drill", "recovery_time_target_unix", "1740830400"),
+					resource.TestCheckResourceAttr("supabase_project_restore.drill", "id", fmt.Sprintf("%s/1740830400", testProjectRef)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectRestoreResource_Validation(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(backupsApiPath).
+		Times(4).
+		Reply(http.StatusOK).
+		JSON(testBackups(true))
+	gock.New(defaultApiEndpoint).
+		Get(backupsApiPath).
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(testBackups(false))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      projectRestoreResourceConfig("recovery_time_target", "2025-01-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`outside the available backup`),
+			},
+			{
+				Config:      projectRestoreResourceConfig("backup_id", "2025-03-02T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`available backups: 2025-03-01T00:00:00Z`),
+			},
+			{
+				Config:             projectRestoreResourceConfig("backup_id", "2025-03-01T00:00:00Z"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      projectRestoreResourceConfig("recovery_time_target", "2025-03-01T12:00:00Z"),
+				ExpectError: regexp.MustCompile(`does not have point in time recovery enabled`),
+			},
+			// Backups are restored through point in time recovery as well
+			{
+				Config:      projectRestoreResourceConfig("backup_id", "2025-03-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`does not have point in time recovery enabled`),
+			},
+		},
+	})
+}
+
+func projectRestoreResourceConfig(attribute, value string) string {
+	return fmt.Sprintf(`
+resource "supabase_project_restore" "drill" {
+  project_ref = %q
+  %s = %q
+}
+`, testProjectRef, attribute, value)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ProjectUrlFunction{}
+
+func NewProjectUrlFunction() function.Function {
+	return &ProjectUrlFunction{}
+}
+
+// ProjectUrlFunction defines the function implementation.
+type ProjectUrlFunction struct{}
+
+func (f *ProjectUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "project_url"
+}
+
+func (f *ProjectUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the API URL of a project",
+		MarkdownDescription: "Returns the public API URL of a project or branch, e.g. `https://<project_ref>.supabase.co`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ref",
+				MarkdownDescription: "Project or branch reference ID",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ProjectUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ref string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ref))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, projectApiUrl(ref)))
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectsDataSource{}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+// ProjectsDataSource defines the data source implementation.
+type ProjectsDataSource struct {
+	client Client
+}
+
+// ProjectsDataSourceModel describes the data source data model.
+type ProjectsDataSourceModel struct {
+	OrganizationId types.String           `tfsdk:"organization_id"`
+	Region         types.String           `tfsdk:"region"`
+	Status         types.String           `tfsdk:"status"`
+	NameRegex      types.String           `tfsdk:"name_regex"`
+	Projects       []ProjectsProjectModel `tfsdk:"projects"`
+}
+
+// ProjectsProjectModel describes a single project in the list.
+type ProjectsProjectModel struct {
+	Id              types.String `tfsdk:"id"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
+	Name            types.String `tfsdk:"name"`
+	Region          types.String `tfsdk:"region"`
+	Status          types.String `tfsdk:"status"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	DatabaseHost    types.String `tfsdk:"database_host"`
+	DatabaseVersion types.String `tfsdk:"database_version"`
+	ApiUrl          types.String `tfsdk:"api_url"`
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Projects data source. Lists all projects accessible with the configured access token, optionally filtered.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Only return projects belonging to this organization slug",
+				Optional:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only return projects located in this region",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return projects with this status, e.g. `ACTIVE_HEALTHY`",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return projects whose name matches this regular expression",
+				Optional:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "Projects matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Project identifier",
+							Computed:            true,
+						},
+						"organization_id": schema.StringAttribute{
+							MarkdownDescription: "Organization slug",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the project",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Region where the project is located",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the project",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp of the project",
+							Computed:            true,
+						},
+						"database_host": schema.StringAttribute{
+							MarkdownDescription: "Hostname of the project database",
+							Computed:            true,
+						},
+						"database_version": schema.StringAttribute{
+							MarkdownDescription: "Postgres version of the project database",
+							Computed:            true,
+						},
+						"api_url": schema.StringAttribute{
+							MarkdownDescription: "URL of the project API",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		d.client = client
+	}
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+
+	httpResp, err := d.client.V1ListAllProjectsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list projects, got error: %s", err))
+		return
+	}
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list projects, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	data.Projects = make([]ProjectsProjectModel, 0)
+	for _, project := range *httpResp.JSON200 {
+		if !data.OrganizationId.IsNull() && !projectInOrganization(&project, data.OrganizationId.ValueString()) {
+			continue
+		}
+		if !data.Region.IsNull() && project.Region != data.Region.ValueString() {
+			continue
+		}
+		if !data.Status.IsNull() && string(project.Status) != data.Status.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(project.Name) {
+			continue
+		}
+
+		var model ProjectResourceModel
+		setProjectAttributes(&model, &project)
+		data.Projects = append(data.Projects, ProjectsProjectModel{
+			Id:              types.StringValue(project.Id),
+			OrganizationId:  model.OrganizationId,
+			Name:            model.Name,
+			Region:          model.Region,
+			Status:          model.Status,
+			CreatedAt:       model.CreatedAt,
+			DatabaseHost:    model.DatabaseHost,
+			DatabaseVersion: model.DatabaseVersion,
+			ApiUrl:          model.ApiUrl,
+		})
+	}
+
+	tflog.Trace(ctx, "read projects data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
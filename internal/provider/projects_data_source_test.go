@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccProjectsDataSource(t *testing.T) {
+	staging := testProjectWithDatabase("staging-api")
+	production := testProjectWithDatabase("production-api")
+	production.Id = "abcdefghijklmnopqrst"
+	paused := testProjectWithDatabase("staging-web")
+	paused.Id = "tsrqponmlkjihgfedcba"
+	paused.Status = api.V1ProjectWithDatabaseResponseStatusINACTIVE
+	otherOrg := testProjectWithDatabase("staging-docs")
+	otherOrg.Id = "klmnopqrstabcdefghij"
+	otherOrg.OrganizationId = "other-org"
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(projectsApiPath).
+		Times(3).
+		Reply(http.StatusOK).
+		JSON([]api.V1ProjectWithDatabaseResponse{staging, production, paused, otherOrg})
+	// Run test
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: examples.ProjectsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.supabase_projects.staging", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.supabase_projects.staging", "projects.0.id", testProjectRef),
+					resource.TestCheckResourceAttr("data.supabase_projects.staging", "projects.0.name", "staging-api"),
+					resource.TestCheckResourceAttr("data.supabase_projects.staging", "projects.0.api_url", "https://"+testProjectRef+".supabase.co"),
+				),
+			},
+		},
+	})
+}
@@ -14,18 +14,31 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/supabase/cli/pkg/api"
 )
 
 // Ensure SupabaseProvider satisfies various provider interfaces.
-var _ provider.Provider = &SupabaseProvider{}
+var (
+	_ provider.Provider                       = &SupabaseProvider{}
+	_ provider.ProviderWithEphemeralResources = &SupabaseProvider{}
+	_ provider.ProviderWithFunctions          = &SupabaseProvider{}
+	_ provider.ProviderWithActions            = &SupabaseProvider{}
+	_ provider.ProviderWithListResources      = &SupabaseProvider{}
+)
 
 // SupabaseProvider defines the provider implementation.
 type SupabaseProvider struct {
@@ -38,8 +51,10 @@ type SupabaseProvider struct {
 
 // SupabaseProviderModel describes the provider data model.
 type SupabaseProviderModel struct {
-	Endpoint    types.String `tfsdk:"endpoint"`
-	AccessToken types.String `tfsdk:"access_token"`
+	Endpoint             types.String `tfsdk:"endpoint"`
+	AccessToken          types.String `tfsdk:"access_token"`
+	ReadCacheTtl         types.String `tfsdk:"read_cache_ttl"`
+	MaxRequestsPerMinute types.Int64  `tfsdk:"max_requests_per_minute"`
 }
 
 const defaultApiEndpoint = "https://api.supabase.com"
@@ -141,6 +156,22 @@ func newRetryableClient(base *http.Client) *http.Client {
 	}
 }
 
+// newApiClient creates a Management API client that authenticates with the
+// given access token and retries transient GET failures. Middlewares wrap the
+// retrying transport, so they observe each request once regardless of retries.
+func newApiClient(endpoint, accessToken, version string, base *http.Client, middlewares ...clientMiddleware) (Client, error) {
+	return newClient(
+		endpoint,
+		api.WithHTTPClient(chainMiddlewares(newRetryableClient(base), middlewares...)),
+		api.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+			req.Header.Set("User-Agent", "TFProvider/"+version)
+			return nil
+		}),
+		api.WithRequestEditorFn(stashRequestMethod),
+	)
+}
+
 func (p *SupabaseProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "supabase"
 	resp.Version = p.version
@@ -163,6 +194,20 @@ func (p *SupabaseProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:  true,
 				Sensitive: true,
 			},
+			"read_cache_ttl": schema.StringAttribute{
+				MarkdownDescription: "How long successful read responses of the Supabase API are reused, as a duration such as `5s`. " +
+					"Any write clears the cache. Keep it well below resource timeouts, since waiting for a change only observes it " +
+					"once cached responses expire. Caching is disabled when unset.",
+				Optional: true,
+			},
+			"max_requests_per_minute": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests sent to the Supabase API per minute. Requests are spaced evenly " +
+					"and wait for a free slot. Responses served from the read cache do not count. Unlimited when unset.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -253,21 +298,15 @@ func (p *SupabaseProvider) Configure(ctx context.Context, req provider.Configure
 			"Set the access token using either the access_token parameter or the SUPABASE_ACCESS_TOKEN environment variable")
 	}
 
+	middlewares, diags := providerClientMiddlewares(&data)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Example client configuration for data sources and resources
-	client, err := api.NewClientWithResponses(
-		apiEndpoint,
-		api.WithHTTPClient(newRetryableClient(p.baseHTTPClient)),
-		api.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-			req.Header.Set("Authorization", "Bearer "+accessToken)
-			req.Header.Set("User-Agent", "TFProvider/"+p.version)
-			return nil
-		}),
-		api.WithRequestEditorFn(stashRequestMethod),
-	)
+	client, err := newApiClient(apiEndpoint, accessToken, p.version, p.baseHTTPClient, middlewares...)
 	if err != nil {
 		tflog.Error(ctx, "NewClientWithResponses Error: "+err.Error())
 		resp.Diagnostics.AddError(
@@ -282,6 +321,39 @@ func (p *SupabaseProvider) Configure(ctx context.Context, req provider.Configure
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
+	resp.ListResourceData = client
+}
+
+// providerClientMiddlewares returns the API client middlewares enabled by the
+// provider configuration. Cached responses are served before the rate limit,
+// so they never wait for a slot. Metrics come last to only count calls that
+// reach the API, without the time spent waiting for the rate limit.
+func providerClientMiddlewares(data *SupabaseProviderModel) ([]clientMiddleware, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	middlewares := []clientMiddleware{loggingMiddleware}
+
+	if !data.ReadCacheTtl.IsNull() && !data.ReadCacheTtl.IsUnknown() {
+		ttl, err := time.ParseDuration(data.ReadCacheTtl.ValueString())
+		if err != nil || ttl <= 0 {
+			diags.AddAttributeError(
+				path.Root("read_cache_ttl"),
+				"Invalid Read Cache TTL",
+				fmt.Sprintf("The read cache TTL %q is not a positive duration. Use a value such as 5s or 1m.", data.ReadCacheTtl.ValueString()),
+			)
+		} else {
+			middlewares = append(middlewares, cachingMiddleware(ttl))
+		}
+	}
+
+	if !data.MaxRequestsPerMinute.IsNull() && !data.MaxRequestsPerMinute.IsUnknown() {
+		middlewares = append(middlewares, rateLimitMiddleware(int(data.MaxRequestsPerMinute.ValueInt64())))
+	}
+
+	middlewares = append(middlewares, newClientMetrics().middleware)
+
+	return middlewares, diags
 }
 
 func (p *SupabaseProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -294,6 +366,14 @@ func (p *SupabaseProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewEdgeFunctionSecretsResource,
 		NewApiKeyResource,
 		NewThirdPartyAuthResource,
+		NewProjectAddonResource,
+		NewDiskResource,
+		NewReadReplicaResource,
+		NewProjectRestoreResource,
+		NewVanitySubdomainResource,
+		NewCustomHostnameResource,
+		NewCustomHostnameActivationResource,
+		NewJwtSigningKeyResource,
 	}
 }
 
@@ -304,6 +384,46 @@ func (p *SupabaseProvider) DataSources(ctx context.Context) []func() datasource.
 		NewPoolerDataSource,
 		NewAPIKeysDataSource,
 		NewNetworkBansDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
+		NewBackupsDataSource,
+	}
+}
+
+func (p *SupabaseProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	tflog.Debug(ctx, "supabase_provider returning ephemeral resources")
+	return []func() ephemeral.EphemeralResource{
+		NewAPIKeysEphemeralResource,
+		NewDatabaseCredentialsEphemeralResource,
+	}
+}
+
+func (p *SupabaseProvider) Functions(ctx context.Context) []func() function.Function {
+	tflog.Debug(ctx, "supabase_provider returning functions")
+	return []func() function.Function{
+		NewProjectUrlFunction,
+		NewConnectionStringFunction,
+		NewParseRefFunction,
+		NewJwtClaimsFunction,
+	}
+}
+
+func (p *SupabaseProvider) Actions(ctx context.Context) []func() action.Action {
+	tflog.Debug(ctx, "supabase_provider returning actions")
+	return []func() action.Action{
+		NewPauseProjectAction,
+		NewRunSqlAction,
+	}
+}
+
+func (p *SupabaseProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	tflog.Debug(ctx, "supabase_provider returning list resources")
+	return []func() list.ListResource{
+		NewProjectListResource,
+		NewBranchListResource,
+		NewEdgeFunctionListResource,
+		NewApiKeyListResource,
+		NewThirdPartyAuthListResource,
 	}
 }
 
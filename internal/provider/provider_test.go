@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"gopkg.in/h2non/gock.v1"
 )
@@ -32,6 +33,19 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"supabase": providerserver.NewProtocol6WithError(newWithBaseClient("test", http.DefaultClient)()),
 }
 
+// testAccEchoProtoV6ProviderFactories additionally include the echo provider,
+// which copies ephemeral resource results into state so tests can inspect them.
+var testAccEchoProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"supabase": providerserver.NewProtocol6WithError(newWithBaseClient("test", http.DefaultClient)()),
+	"echo":     echoprovider.NewProviderServer(),
+}
+
+// testAccQueryProviderConfig declares the provider, which query mode requires
+// before list blocks can reference it.
+const testAccQueryProviderConfig = `
+provider "supabase" {}
+`
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
@@ -390,3 +404,52 @@ data "supabase_branch" "test" {
 		},
 	})
 }
+
+func TestAccProviderConfigure_ClientOptions(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(branchesApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]map[string]any{})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "supabase" {
+  read_cache_ttl          = "1m"
+  max_requests_per_minute = 600
+}
+
+data "supabase_branch" "test" {
+  parent_project_ref = "%s"
+}
+`, testProjectRef),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_ReadCacheInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "supabase" {
+  read_cache_ttl = "soon"
+}
+
+data "supabase_branch" "test" {
+  parent_project_ref = "%s"
+}
+`, testProjectRef),
+				ExpectError: regexp.MustCompile("Invalid Read Cache TTL"),
+			},
+		},
+	})
+}
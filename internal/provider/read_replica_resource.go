@@ -0,0 +1,628 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ReadReplicaResource{}
+	_ resource.ResourceWithImportState = &ReadReplicaResource{}
+	_ resource.ResourceWithIdentity    = &ReadReplicaResource{}
+)
+
+var readReplicaRegions = []string{
+	string(api.SetUpReadReplicaBodyReadReplicaRegionApEast1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionApNortheast1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionApNortheast2),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionApSouth1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionApSoutheast1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionApSoutheast2),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionCaCentral1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionEuCentral1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionEuCentral2),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionEuNorth1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionEuWest1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionEuWest2),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionEuWest3),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionSaEast1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionUsEast1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionUsEast2),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionUsWest1),
+	string(api.SetUpReadReplicaBodyReadReplicaRegionUsWest2),
+}
+
+const (
+	readReplicaStatusPending = "READ_REPLICA_STATUS_PENDING"
+	readReplicaStatusDone    = "READ_REPLICA_STATUS_DONE"
+)
+
+// Database statuses a new read replica passes through before it is healthy.
+var readReplicaPendingStatuses = []string{
+	readReplicaStatusPending,
+	string(api.OrganizationProjectsResponseProjectsDatabasesStatusINITREADREPLICA),
+	string(api.OrganizationProjectsResponseProjectsDatabasesStatusCOMINGUP),
+	string(api.OrganizationProjectsResponseProjectsDatabasesStatusACTIVEUNHEALTHY),
+	string(api.OrganizationProjectsResponseProjectsDatabasesStatusRESTARTING),
+	string(api.OrganizationProjectsResponseProjectsDatabasesStatusRESIZING),
+	string(api.OrganizationProjectsResponseProjectsDatabasesStatusUNKNOWN),
+}
+
+func NewReadReplicaResource() resource.Resource {
+	return &ReadReplicaResource{}
+}
+
+// ReadReplicaResource defines the resource implementation.
+type ReadReplicaResource struct {
+	client Client
+}
+
+// ReadReplicaResourceModel describes the resource data model.
+type ReadReplicaResourceModel struct {
+	ProjectRef       types.String   `tfsdk:"project_ref"`
+	Region           types.String   `tfsdk:"region"`
+	Id               types.String   `tfsdk:"id"`
+	DatabaseHost     types.String   `tfsdk:"database_host"`
+	ConnectionString types.String   `tfsdk:"connection_string"`
+	PoolerHost       types.String   `tfsdk:"pooler_host"`
+	PoolerUrl        types.Map      `tfsdk:"pooler_url"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ReadReplicaResourceIdentityModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func readReplicaIdentity(data *ReadReplicaResourceModel) ReadReplicaResourceIdentityModel {
+	return ReadReplicaResourceIdentityModel{
+		ProjectRef: data.ProjectRef,
+		Id:         data.Id,
+	}
+}
+
+func (r *ReadReplicaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_read_replica"
+}
+
+func (r *ReadReplicaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read replica resource. Sets up a read replica of a project's database in the chosen region " +
+			"and waits until the replica database is healthy.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region the read replica resides in",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(readReplicaRegions...),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Database identifier of the read replica",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_host": schema.StringAttribute{
+				MarkdownDescription: "Hostname for direct connections to the read replica database",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_string": schema.StringAttribute{
+				MarkdownDescription: "Direct connection string of the read replica for the `postgres` user, without the password",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pooler_host": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the connection pooler for the read replica",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pooler_url": schema.MapAttribute{
+				MarkdownDescription: "Map of pooler mode to connection string for the read replica",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ReadReplicaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_ref": identityschema.StringAttribute{
+				Description:       "Project reference ID",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "Read replica database identifier",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ReadReplicaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *ReadReplicaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReadReplicaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(createReadReplica(ctx, &data, r.client, createTimeout)...)
+	if resp.Diagnostics.HasError() {
+		// Save a replica that was set up but did not become healthy, so Terraform taints it instead of creating another
+		if !data.Id.IsUnknown() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, readReplicaIdentity(&data))...)
+		}
+		return
+	}
+
+	tflog.Trace(ctx, "created read replica")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, readReplicaIdentity(&data))...)
+}
+
+func (r *ReadReplicaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReadReplicaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readReadReplica(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read read replica")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, readReplicaIdentity(&data))...)
+}
+
+func (r *ReadReplicaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReadReplicaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every configurable attribute requires replacement, only timeouts can change here.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, readReplicaIdentity(&data))...)
+}
+
+func (r *ReadReplicaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReadReplicaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteReadReplica(ctx, &data, r.client, deleteTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "deleted read replica")
+}
+
+func (r *ReadReplicaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity ReadReplicaResourceIdentityModel
+	if req.ID != "" {
+		parts := strings.SplitN(req.ID, "/", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected import ID in format 'project_ref/database_identifier', got: %s", req.ID),
+			)
+			return
+		}
+		identity.ProjectRef = types.StringValue(strings.TrimSpace(parts[0]))
+		identity.Id = types.StringValue(strings.TrimSpace(parts[1]))
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data := ReadReplicaResourceModel{
+		ProjectRef: identity.ProjectRef,
+		Id:         identity.Id,
+		Region:     types.StringNull(),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	found, diags := readReadReplica(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("Read replica %s does not exist in project %s", data.Id.ValueString(), data.ProjectRef.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, readReplicaIdentity(&data))...)
+}
+
+// readReplicaRegion infers the region from a replica identifier of the form <project_ref>-rr-<region>-<suffix>.
+func readReplicaRegion(projectRef, identifier string) (string, bool) {
+	prefix := projectRef + "-rr-"
+	if !strings.HasPrefix(identifier, prefix) {
+		return "", false
+	}
+	for _, region := range readReplicaRegions {
+		if strings.HasPrefix(identifier, prefix+region+"-") {
+			return region, true
+		}
+	}
+	return "", false
+}
+
+// readReplicaConnectionString returns the direct connection string of a replica,
+// which is served like a project database under the replica identifier.
+func readReplicaConnectionString(identifier string) string {
+	return postgresUrl(url.User(defaultDatabaseUser), projectDbHost(identifier), defaultDatabasePort, defaultDatabaseName)
+}
+
+// newReadReplicaIdentifiers returns the replicas in region that did not exist
+// before setup. The setup endpoint does not return the new identifier, so it
+// can only be derived from the difference between both listings.
+func newReadReplicaIdentifiers(projectRef, region string, known map[string]bool, replicas []api.SupavisorConfigResponse) []string {
+	var identifiers []string
+	for _, replica := range replicas {
+		if known[replica.Identifier] || slices.Contains(identifiers, replica.Identifier) {
+			continue
+		}
+		if replicaRegion, ok := readReplicaRegion(projectRef, replica.Identifier); !ok || replicaRegion != region {
+			continue
+		}
+		identifiers = append(identifiers, replica.Identifier)
+	}
+	return identifiers
+}
+
+// readReplicaStatus returns the database status of a replica. Replica health is
+// only reported by the organization projects endpoint, which is searched by
+// the project name. An empty status means the replica is not listed yet.
+func readReplicaStatus(ctx context.Context, projectRef, identifier string, client Client) (string, error) {
+	projectResp, err := client.V1GetProjectWithResponse(ctx, projectRef)
+	if err != nil {
+		return "", err
+	}
+	if projectResp.JSON200 == nil {
+		return "", fmt.Errorf("unexpected status %d: %s", projectResp.StatusCode(), projectResp.Body)
+	}
+	project := projectResp.JSON200
+	slug := project.OrganizationSlug
+	if slug == "" {
+		slug = project.OrganizationId
+	}
+
+	const limit = 100
+	params := api.V1GetAllProjectsForOrganizationParams{
+		Search: Ptr(project.Name),
+		Limit:  Ptr(limit),
+	}
+	for offset := 0; ; offset += limit {
+		params.Offset = Ptr(offset)
+		httpResp, err := client.V1GetAllProjectsForOrganizationWithResponse(ctx, slug, &params)
+		if err != nil {
+			return "", err
+		}
+		if httpResp.JSON200 == nil {
+			return "", fmt.Errorf("unexpected status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		for _, p := range httpResp.JSON200.Projects {
+			if p.Ref != projectRef {
+				continue
+			}
+			for _, db := range p.Databases {
+				if db.Identifier == identifier {
+					return string(db.Status), nil
+				}
+			}
+			return "", nil
+		}
+		if len(httpResp.JSON200.Projects) < limit {
+			return "", nil
+		}
+	}
+}
+
+func listReadReplicas(ctx context.Context, projectRef string, client Client) ([]api.SupavisorConfigResponse, error) {
+	httpResp, err := client.V1GetPoolerConfigWithResponse(ctx, projectRef)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status %d: %s", httpResp.StatusCode(), httpResp.Body)
+	}
+
+	var replicas []api.SupavisorConfigResponse
+	for _, pooler := range *httpResp.JSON200 {
+		if pooler.DatabaseType == api.SupavisorConfigResponseDatabaseTypeREADREPLICA {
+			replicas = append(replicas, pooler)
+		}
+	}
+	return replicas, nil
+}
+
+// setReadReplicaAttributes copies the pooler entries of a single replica into the model.
+func setReadReplicaAttributes(data *ReadReplicaResourceModel, replicas []api.SupavisorConfigResponse) bool {
+	poolerUrl := map[string]attr.Value{}
+	for _, pooler := range replicas {
+		if pooler.Identifier != data.Id.ValueString() {
+			continue
+		}
+		data.PoolerHost = types.StringValue(pooler.DbHost)
+		poolerUrl[string(pooler.PoolMode)] = types.StringValue(pooler.ConnectionString)
+	}
+	if len(poolerUrl) == 0 {
+		return false
+	}
+
+	data.PoolerUrl = types.MapValueMust(types.StringType, poolerUrl)
+	data.DatabaseHost = types.StringValue(projectDbHost(data.Id.ValueString()))
+	data.ConnectionString = types.StringValue(readReplicaConnectionString(data.Id.ValueString()))
+	if data.Region.IsNull() {
+		if region, ok := readReplicaRegion(data.ProjectRef.ValueString(), data.Id.ValueString()); ok {
+			data.Region = types.StringValue(region)
+		}
+	}
+	return true
+}
+
+func readReadReplica(ctx context.Context, data *ReadReplicaResourceModel, client Client) (bool, diag.Diagnostics) {
+	replicas, err := listReadReplicas(ctx, data.ProjectRef.ValueString(), client)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read read replicas, got error: %s", err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	if !setReadReplicaAttributes(data, replicas) {
+		tflog.Trace(ctx, fmt.Sprintf("read replica not found: %s", data.Id.ValueString()))
+		return false, nil
+	}
+	return true, nil
+}
+
+func createReadReplica(ctx context.Context, data *ReadReplicaResourceModel, client Client, timeout time.Duration) diag.Diagnostics {
+	projectRef := data.ProjectRef.ValueString()
+
+	// The setup endpoint does not return the new identifier, so compare against the existing replicas
+	existing, err := listReadReplicas(ctx, projectRef, client)
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read read replicas, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	known := map[string]bool{}
+	for _, replica := range existing {
+		known[replica.Identifier] = true
+	}
+
+	httpResp, err := client.V1SetupAReadReplicaWithResponse(ctx, projectRef, api.V1SetupAReadReplicaJSONRequestBody{
+		ReadReplicaRegion: api.SetUpReadReplicaBodyReadReplicaRegion(data.Region.ValueString()),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to set up read replica, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusCreated {
+		msg := fmt.Sprintf("Unable to set up read replica, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{readReplicaStatusPending},
+		Target:  []string{readReplicaStatusDone},
+		Refresh: func() (any, string, error) {
+			replicas, err := listReadReplicas(ctx, projectRef, client)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get read replicas: %w", err)
+			}
+			switch identifiers := newReadReplicaIdentifiers(projectRef, data.Region.ValueString(), known, replicas); len(identifiers) {
+			case 0:
+			case 1:
+				data.Id = types.StringValue(identifiers[0])
+				return replicas, readReplicaStatusDone, nil
+			default:
+				return nil, "", fmt.Errorf("several read replicas were set up in %s at the same time: %s, import the replica that belongs to this resource",
+					data.Region.ValueString(), strings.Join(identifiers, ", "))
+			}
+			tflog.Debug(ctx, "Waiting for read replica to come up", map[string]any{
+				"project_ref": projectRef,
+				"region":      data.Region.ValueString(),
+			})
+			return nil, readReplicaStatusPending, nil
+		},
+		Timeout: timeout,
+	}
+
+	replicas, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Read Replica Not Ready",
+			fmt.Sprintf("Read replica of project %s in %s did not come up within timeout: %s", projectRef, data.Region.ValueString(), err),
+		)}
+	}
+	setReadReplicaAttributes(data, replicas.([]api.SupavisorConfigResponse))
+
+	healthConf := &retry.StateChangeConf{
+		Pending: readReplicaPendingStatuses,
+		Target:  []string{string(api.OrganizationProjectsResponseProjectsDatabasesStatusACTIVEHEALTHY)},
+		Refresh: func() (any, string, error) {
+			status, err := readReplicaStatus(ctx, projectRef, data.Id.ValueString(), client)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get read replica status: %w", err)
+			}
+			if status == "" {
+				status = readReplicaStatusPending
+			}
+			tflog.Debug(ctx, "Waiting for read replica to become healthy", map[string]any{
+				"project_ref": projectRef,
+				"id":          data.Id.ValueString(),
+				"status":      status,
+			})
+			return status, status, nil
+		},
+		Timeout: timeout,
+	}
+
+	if _, err := healthConf.WaitForStateContext(ctx); err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Read Replica Not Ready",
+			fmt.Sprintf("Read replica %s did not become healthy within timeout: %s", data.Id.ValueString(), err),
+		)}
+	}
+
+	// Wait for project to settle once the replica is provisioned
+	return waitForProjectActive(ctx, projectRef, client, timeout)
+}
+
+func deleteReadReplica(ctx context.Context, data *ReadReplicaResourceModel, client Client, timeout time.Duration) diag.Diagnostics {
+	projectRef := data.ProjectRef.ValueString()
+
+	httpResp, err := client.V1RemoveAReadReplicaWithResponse(ctx, projectRef, api.V1RemoveAReadReplicaJSONRequestBody{
+		DatabaseIdentifier: data.Id.ValueString(),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to remove read replica, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("read replica not found: %s", data.Id.ValueString()))
+		return nil
+	}
+	if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusCreated {
+		msg := fmt.Sprintf("Unable to remove read replica, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{readReplicaStatusPending},
+		Target:  []string{readReplicaStatusDone},
+		Refresh: func() (any, string, error) {
+			replicas, err := listReadReplicas(ctx, projectRef, client)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get read replicas: %w", err)
+			}
+			if slices.ContainsFunc(replicas, func(r api.SupavisorConfigResponse) bool {
+				return r.Identifier == data.Id.ValueString()
+			}) {
+				return nil, readReplicaStatusPending, nil
+			}
+			return replicas, readReplicaStatusDone, nil
+		},
+		Timeout: timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Read Replica Not Removed",
+			fmt.Sprintf("Read replica %s was not removed within timeout: %s", data.Id.ValueString(), err),
+		)}
+	}
+
+	return nil
+}
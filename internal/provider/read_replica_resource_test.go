@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+const testReadReplicaId = testProjectRef + "-rr-eu-west-1-abcde"
+
+func testReadReplicaPoolerConfig() []api.SupavisorConfigResponse {
+	config := append([]api.SupavisorConfigResponse{}, testPoolerConfig...)
+	for _, mode := range []api.SupavisorConfigResponsePoolMode{
+		api.SupavisorConfigResponsePoolModeSession,
+		api.SupavisorConfigResponsePoolModeTransaction,
+	} {
+		config = append(config, api.SupavisorConfigResponse{
+			Identifier:       testReadReplicaId,
+			DatabaseType:     api.SupavisorConfigResponseDatabaseTypeREADREPLICA,
+			DbHost:           "aws-0-eu-west-1.pooler.supabase.com",
+			PoolMode:         mode,
+			ConnectionString: "postgres://postgres." + testReadReplicaId + "@aws-0-eu-west-1.pooler.supabase.com/" + string(mode),
+		})
+	}
+	return config
+}
+
+// testOrganizationProjects lists the test project with its primary database and
+// the test read replica in the given status.
+func testOrganizationProjects(replicaStatus string) map[string]any {
+	return map[string]any{
+		"pagination": map[string]any{"count": 1, "limit": 100, "offset": 0},
+		"projects": []map[string]any{{
+			"ref":  testProjectRef,
+			"name": "foo",
+			"databases": []map[string]any{
+				{"identifier": testProjectRef, "type": "PRIMARY", "status": "ACTIVE_HEALTHY"},
+				{"identifier": testReadReplicaId, "type": "READ_REPLICA", "status": replicaStatus},
+			},
+		}},
+	}
+}
+
+func TestAccReadReplicaResource(t *testing.T) {
+	defer gock.OffAll()
+	// Step 1: create
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
+	gock.New(defaultApiEndpoint).
+		Post(readReplicaSetupApiPath).
+		AddMatcher(matchJSONBodyField("read_replica_region", "eu-west-1")).
+		Reply(http.StatusCreated)
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Times(5).
+		Reply(http.StatusOK).
+		JSON(testReadReplicaPoolerConfig())
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+	gock.New(defaultApiEndpoint).
+		Get(organizationProjectsApiPath).
+		MatchParam("search", "foo").
+		Reply(http.StatusOK).
+		JSON(testOrganizationProjects(string(api.OrganizationProjectsResponseProjectsDatabasesStatusACTIVEHEALTHY)))
+	// Delete
+	gock.New(defaultApiEndpoint).
+		Post(readReplicaRemoveApiPath).
+		AddMatcher(matchJSONBodyField("database_identifier", testReadReplicaId)).
+		Reply(http.StatusCreated)
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.ReadReplicaResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_read_replica.eu", "id", testReadReplicaId),
+					resource.TestCheckResourceAttr("supabase_read_replica.eu", "region", "eu-west-1"),
+					resource.TestCheckResourceAttr("supabase_read_replica.eu", "pooler_host", "aws-0-eu-west-1.pooler.supabase.com"),
+					resource.TestCheckResourceAttr("supabase_read_replica.eu", "pooler_url.%", "2"),
+					resource.TestCheckResourceAttr("supabase_read_replica.eu", "database_host", "db."+testReadReplicaId+".supabase.co"),
+					resource.TestCheckResourceAttr("supabase_read_replica.eu", "connection_string", "postgresql://postgres@db."+testReadReplicaId+".supabase.co:5432/postgres"),
+					resource.TestCheckResourceAttrSet("supabase_read_replica.eu", "pooler_url.transaction"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_read_replica.eu",
+				ImportState:       true,
+				ImportStateId:     testProjectRef + "/" + testReadReplicaId,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccReadReplicaResource_NotHealthy(t *testing.T) {
+	defer gock.OffAll()
+	// The replica stays listed until it is removed during destroy
+	var removed atomic.Bool
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
+	gock.New(defaultApiEndpoint).
+		Post(readReplicaSetupApiPath).
+		Reply(http.StatusCreated)
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Persist().
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { return !removed.Load(), nil }).
+		Reply(http.StatusOK).
+		JSON(testReadReplicaPoolerConfig())
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+	gock.New(defaultApiEndpoint).
+		Get(organizationProjectsApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testOrganizationProjects(string(api.OrganizationProjectsResponseProjectsDatabasesStatusCOMINGUP)))
+	// Delete
+	gock.New(defaultApiEndpoint).
+		Post(readReplicaRemoveApiPath).
+		AddMatcher(matchJSONBodyField("database_identifier", testReadReplicaId)).
+		AddMatcher(func(*http.Request, *gock.Request) (bool, error) { removed.Store(true); return true, nil }).
+		Reply(http.StatusCreated)
+	gock.New(defaultApiEndpoint).
+		Get(poolerApiPath).
+		Persist().
+		Reply(http.StatusOK).
+		JSON(testPoolerConfig)
+
+	const config = `
+resource "supabase_read_replica" "eu" {
+  project_ref = "` + testProjectRef + `"
+  region      = "eu-west-1"
+
+  timeouts {
+    create = "2s"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`did not become healthy`),
+			},
+			// The unhealthy replica is kept in state as tainted and replaced on the next apply
+			{
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_read_replica.eu", "id", testReadReplicaId),
+				),
+			},
+		},
+	})
+}
+
+func TestNewReadReplicaIdentifiers(t *testing.T) {
+	known := map[string]bool{testProjectRef + "-rr-eu-west-1-known": true}
+	replicas := []api.SupavisorConfigResponse{
+		{Identifier: testProjectRef + "-rr-eu-west-1-known"},
+		{Identifier: testProjectRef + "-rr-us-east-1-other"},
+		{Identifier: testProjectRef + "-rr-eu-west-1-first", PoolMode: api.SupavisorConfigResponsePoolModeSession},
+		{Identifier: testProjectRef + "-rr-eu-west-1-first", PoolMode: api.SupavisorConfigResponsePoolModeTransaction},
+	}
+
+	// Each replica is listed once per pool mode
+	identifiers := newReadReplicaIdentifiers(testProjectRef, "eu-west-1", known, replicas)
+	if len(identifiers) != 1 || identifiers[0] != testProjectRef+"-rr-eu-west-1-first" {
+		t.Errorf("Expected only the new replica in eu-west-1, got %v", identifiers)
+	}
+
+	// Replicas set up in parallel cannot be told apart
+	replicas = append(replicas, api.SupavisorConfigResponse{Identifier: testProjectRef + "-rr-eu-west-1-second"})
+	if identifiers := newReadReplicaIdentifiers(testProjectRef, "eu-west-1", known, replicas); len(identifiers) != 2 {
+		t.Errorf("Expected both new replicas in eu-west-1, got %v", identifiers)
+	}
+
+	// Replicas in other regions are never taken
+	if identifiers := newReadReplicaIdentifiers(testProjectRef, "ap-south-1", known, replicas); len(identifiers) != 0 {
+		t.Errorf("Expected no replica in ap-south-1, got %v", identifiers)
+	}
+}
+
+func TestReadReplicaStatus(t *testing.T) {
+	defer gock.OffAll()
+	gock.InterceptClient(http.DefaultClient)
+	defer gock.RestoreClient(http.DefaultClient)
+
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("foo"))
+	gock.New(defaultApiEndpoint).
+		Get(organizationProjectsApiPath).
+		Reply(http.StatusOK).
+		JSON(testOrganizationProjects(string(api.OrganizationProjectsResponseProjectsDatabasesStatusINITREADREPLICA)))
+	gock.New(defaultApiEndpoint).
+		Get(organizationProjectsApiPath).
+		Reply(http.StatusOK).
+		JSON(testOrganizationProjects(string(api.OrganizationProjectsResponseProjectsDatabasesStatusACTIVEHEALTHY)))
+
+	client, err := newClient(defaultApiEndpoint)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	for _, want := range []string{"INIT_READ_REPLICA", "ACTIVE_HEALTHY"} {
+		status, err := readReplicaStatus(t.Context(), testProjectRef, testReadReplicaId, client)
+		if err != nil {
+			t.Fatalf("Failed to read replica status: %v", err)
+		}
+		if status != want {
+			t.Errorf("Expected status %s, got %s", want, status)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &RunSqlAction{}
+	_ action.ActionWithConfigure = &RunSqlAction{}
+)
+
+func NewRunSqlAction() action.Action {
+	return &RunSqlAction{}
+}
+
+// RunSqlAction defines the action implementation.
+type RunSqlAction struct {
+	client Client
+}
+
+// RunSqlActionModel describes the action data model.
+type RunSqlActionModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+	Query      types.String `tfsdk:"query"`
+	ReadOnly   types.Bool   `tfsdk:"read_only"`
+}
+
+func (a *RunSqlAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_sql"
+}
+
+func (a *RunSqlAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a SQL query against the database of a project or branch once it is active.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project or branch reference ID",
+				Required:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "SQL query to run",
+				Required:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to run the query in a read-only transaction. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *RunSqlAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		a.client = client
+	}
+}
+
+func (a *RunSqlAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RunSqlActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectRef := data.ProjectRef.ValueString()
+	resp.Diagnostics.Append(waitForProjectActive(ctx, projectRef, a.client, defaultWaitTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := a.client.V1RunAQueryWithResponse(ctx, projectRef, api.V1RunQueryBody{
+		Query:    data.Query.ValueString(),
+		ReadOnly: data.ReadOnly.ValueBoolPointer(),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to run query, got error: %s", err)
+		resp.Diagnostics.AddError("Client Error", msg)
+		return
+	}
+	if httpResp.StatusCode() != http.StatusCreated && httpResp.StatusCode() != http.StatusOK {
+		msg := fmt.Sprintf("Unable to run query, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		resp.Diagnostics.AddError("Client Error", msg)
+		return
+	}
+
+	var rows []json.RawMessage
+	if err := json.Unmarshal(httpResp.Body, &rows); err == nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Query returned %d rows", len(rows))})
+	}
+
+	tflog.Trace(ctx, "ran sql query")
+}
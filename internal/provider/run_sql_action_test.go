@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccRunSqlAction(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("production"))
+	gock.New(defaultApiEndpoint).
+		Post(projectApiPath + "/database/query").
+		AddMatcher(matchJSONBodyField("query", "refresh materialized view concurrently public.daily_stats")).
+		Reply(http.StatusCreated).
+		JSON([]any{})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invoke testing
+			{
+				Config: examples.RunSqlActionConfig + testActionTrigger("action.supabase_run_sql.refresh_stats"),
+			},
+		},
+	})
+}
+
+func TestAccRunSqlAction_QueryError(t *testing.T) {
+	// Setup mock api
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Get(projectApiPath).
+		Reply(http.StatusOK).
+		JSON(testProjectWithDatabase("production"))
+	gock.New(defaultApiEndpoint).
+		Post(projectApiPath + "/database/query").
+		Reply(http.StatusBadRequest).
+		JSON(map[string]string{"message": `relation "public.daily_stats" does not exist`})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      examples.RunSqlActionConfig + testActionTrigger("action.supabase_run_sql.refresh_stats"),
+				ExpectError: regexp.MustCompile(`Unable to run query, got status 400`),
+			},
+		},
+	})
+}
@@ -31,6 +31,7 @@ import (
 var (
 	_ resource.Resource                = &SettingsResource{}
 	_ resource.ResourceWithImportState = &SettingsResource{}
+	_ resource.ResourceWithIdentity    = &SettingsResource{}
 )
 
 // Backend returns this 400 body fragment for unsupported projects.
@@ -51,7 +52,7 @@ func NewSettingsResource() resource.Resource {
 
 // SettingsResource defines the resource implementation.
 type SettingsResource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 // SettingsResourceModel describes the resource data model.
@@ -141,6 +142,10 @@ func (r *SettingsResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+func (r *SettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectRefIdentitySchema()
+}
+
 func (r *SettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
 		r.client = client
@@ -205,6 +210,7 @@ func (r *SettingsResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
 }
 
 func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -245,6 +251,7 @@ func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
 }
 
 func (r *SettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -305,6 +312,7 @@ func (r *SettingsResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(planData.ProjectRef))...)
 }
 
 func (r *SettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -320,8 +328,14 @@ func (r *SettingsResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *SettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectRef, diags := importProjectRef(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data := SettingsResourceModel{
-		Id: types.StringValue(req.ID),
+		Id: types.StringValue(projectRef),
 		Timeouts: timeouts.Value{
 			Object: types.ObjectNull(map[string]attr.Type{
 				"create": types.StringType,
@@ -340,9 +354,10 @@ func (r *SettingsResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(readSslEnforcementConfig(ctx, &data, r.client)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.Id))...)
 }
 
-func readApiConfig(ctx context.Context, state *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readApiConfig(ctx context.Context, state *SettingsResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1GetPostgrestServiceConfigWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read api settings, got error: %s", err)
@@ -357,7 +372,7 @@ func readApiConfig(ctx context.Context, state *SettingsResourceModel, client *ap
 		msg := fmt.Sprintf("Unable to read api settings, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
 	}
-	// TODO: API doesn't support updating jwt secret
+	// API doesn't support updating jwt secret, asymmetric keys are managed by supabase_jwt_signing_key
 	httpResp.JSON200.JwtSecret = nil
 	if state.Api, err = parseConfig(state.Api, *httpResp.JSON200); err != nil {
 		msg := fmt.Sprintf("Unable to read api settings, got error: %s", err)
@@ -366,7 +381,7 @@ func readApiConfig(ctx context.Context, state *SettingsResourceModel, client *ap
 	return nil
 }
 
-func updateApiConfig(ctx context.Context, plan *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateApiConfig(ctx context.Context, plan *SettingsResourceModel, client Client) diag.Diagnostics {
 	var body api.V1UpdatePostgrestConfigBody
 	if diags := plan.Api.Unmarshal(&body); diags.HasError() {
 		return diags
@@ -389,7 +404,7 @@ func updateApiConfig(ctx context.Context, plan *SettingsResourceModel, client *a
 	return nil
 }
 
-func readAuthConfig(ctx context.Context, state *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readAuthConfig(ctx context.Context, state *SettingsResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1GetAuthServiceConfigWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read auth settings, got error: %s", err)
@@ -424,7 +439,7 @@ func readAuthConfig(ctx context.Context, state *SettingsResourceModel, client *a
 	return nil
 }
 
-func updateAuthConfig(ctx context.Context, plan *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateAuthConfig(ctx context.Context, plan *SettingsResourceModel, client Client) diag.Diagnostics {
 	var body api.UpdateAuthConfigBody
 	if diags := plan.Auth.Unmarshal(&body); diags.HasError() {
 		return diags
@@ -452,7 +467,7 @@ func updateAuthConfig(ctx context.Context, plan *SettingsResourceModel, client *
 	return nil
 }
 
-func readDatabaseConfig(ctx context.Context, state *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readDatabaseConfig(ctx context.Context, state *SettingsResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1GetPostgresConfigWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read database settings, got error: %s", err)
@@ -474,7 +489,7 @@ func readDatabaseConfig(ctx context.Context, state *SettingsResourceModel, clien
 	return nil
 }
 
-func updateDatabaseConfig(ctx context.Context, plan *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateDatabaseConfig(ctx context.Context, plan *SettingsResourceModel, client Client) diag.Diagnostics {
 	var body api.UpdatePostgresConfigBody
 	if diags := plan.Database.Unmarshal(&body); diags.HasError() {
 		return diags
@@ -497,7 +512,7 @@ func updateDatabaseConfig(ctx context.Context, plan *SettingsResourceModel, clie
 	return nil
 }
 
-func readSslEnforcementConfig(ctx context.Context, state *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readSslEnforcementConfig(ctx context.Context, state *SettingsResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1GetSslEnforcementConfigWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read SSL enforcement config, got error: %s", err)
@@ -525,7 +540,7 @@ func readSslEnforcementConfig(ctx context.Context, state *SettingsResourceModel,
 	return nil
 }
 
-func updateSslEnforcementConfig(ctx context.Context, plan *SettingsResourceModel, client *api.ClientWithResponses, timeout time.Duration) diag.Diagnostics {
+func updateSslEnforcementConfig(ctx context.Context, plan *SettingsResourceModel, client Client, timeout time.Duration) diag.Diagnostics {
 	desired := plan.SslEnforcement.ValueBool()
 	var body api.SslEnforcementRequest
 	body.RequestedConfig.Database = desired
@@ -756,7 +771,7 @@ type NetworkConfig struct {
 	Restrictions []string `json:"restrictions,omitempty"`
 }
 
-func readNetworkConfig(ctx context.Context, state *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readNetworkConfig(ctx context.Context, state *SettingsResourceModel, client Client) diag.Diagnostics {
 	httpResp, err := client.V1GetNetworkRestrictionsWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
 		msg := fmt.Sprintf("Unable to read network settings, got error: %s", err)
@@ -787,7 +802,7 @@ func readNetworkConfig(ctx context.Context, state *SettingsResourceModel, client
 	return nil
 }
 
-func updateNetworkConfig(ctx context.Context, plan *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateNetworkConfig(ctx context.Context, plan *SettingsResourceModel, client Client) diag.Diagnostics {
 	var network NetworkConfig
 	if diags := plan.Network.Unmarshal(&network); diags.HasError() {
 		return diags
@@ -831,7 +846,7 @@ func updateNetworkConfig(ctx context.Context, plan *SettingsResourceModel, clien
 	return nil
 }
 
-func readStorageConfig(ctx context.Context, state *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func readStorageConfig(ctx context.Context, state *SettingsResourceModel, client Client) diag.Diagnostics {
 	// Use ProjectRef if Id is not set (during Create), otherwise use Id (during Read/Import)
 	projectRef := state.Id.ValueString()
 	if projectRef == "" {
@@ -860,7 +875,7 @@ func readStorageConfig(ctx context.Context, state *SettingsResourceModel, client
 	return nil
 }
 
-func updateStorageConfig(ctx context.Context, plan *SettingsResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func updateStorageConfig(ctx context.Context, plan *SettingsResourceModel, client Client) diag.Diagnostics {
 	var body api.UpdateStorageConfigBody
 	if diags := plan.Storage.Unmarshal(&body); diags.HasError() {
 		return diags
@@ -1827,3 +1827,78 @@ resource "supabase_settings" "branch" {
 		},
 	})
 }
+
+func TestAccSettingsResource_FakeAPI(t *testing.T) {
+	fake := newFakeManagementAPI(t)
+	projectRef := fake.addProject("main")
+	config := func(maxRows int) string {
+		return fmt.Sprintf(`
+resource "supabase_settings" "test" {
+  project_ref = "%s"
+
+  api = jsonencode({
+    db_schema = "public,storage,graphql_public"
+    max_rows  = %d
+  })
+
+  auth = jsonencode({
+    site_url = "http://localhost:3001"
+  })
+}
+`, projectRef, maxRows)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { fake.preCheck(t) },
+		ProtoV6ProviderFactories: fake.providerFactories(),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config(500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_settings.test", "id", projectRef),
+					resource.TestCheckResourceAttr("supabase_settings.test", "api", `{"db_schema":"public,storage,graphql_public","max_rows":500}`),
+					resource.TestCheckResourceAttr("supabase_settings.test", "auth", `{"site_url":"http://localhost:3001"}`),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: config(2000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_settings.test", "api", `{"db_schema":"public,storage,graphql_public","max_rows":2000}`),
+				),
+			},
+			// ImportState reads back what the previous steps applied
+			{
+				ResourceName: "supabase_settings.test",
+				ImportState:  true,
+				ImportStateCheck: func(is []*terraform.InstanceState) error {
+					if len(is) != 1 {
+						return errors.New("expected a single resource in the state")
+					}
+
+					api, err := unmarshalStateAttr(is[0], "api")
+					if err != nil {
+						return err
+					}
+					if api["max_rows"] != float64(2000) {
+						return fmt.Errorf("expected api.max_rows to be 2000, got %v", api["max_rows"])
+					}
+					if api["db_extra_search_path"] != "public,extensions" {
+						return fmt.Errorf("expected api.db_extra_search_path to be public,extensions, got %v", api["db_extra_search_path"])
+					}
+
+					auth, err := unmarshalStateAttr(is[0], "auth")
+					if err != nil {
+						return err
+					}
+					if auth["site_url"] != "http://localhost:3001" {
+						return fmt.Errorf("expected auth.site_url to be http://localhost:3001, got %v", auth["site_url"])
+					}
+
+					return nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &ThirdPartyAuthListResource{}
+	_ list.ListResourceWithConfigure = &ThirdPartyAuthListResource{}
+)
+
+func NewThirdPartyAuthListResource() list.ListResource {
+	return &ThirdPartyAuthListResource{}
+}
+
+// ThirdPartyAuthListResource defines the list resource implementation.
+type ThirdPartyAuthListResource struct {
+	client Client
+}
+
+// ThirdPartyAuthListResourceModel describes the list resource config model.
+type ThirdPartyAuthListResourceModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+}
+
+func (r *ThirdPartyAuthListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_third_party_auth"
+}
+
+func (r *ThirdPartyAuthListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the third-party auth integrations of a project",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ThirdPartyAuthListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *ThirdPartyAuthListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ThirdPartyAuthListResourceModel
+
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	httpResp, err := r.client.V1ListProjectTpaIntegrationsWithResponse(ctx, config.ProjectRef.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to list third-party auth integrations, got error: %s", err)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to list third-party auth integrations, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)})
+		return
+	}
+
+	stream.Results = listResults(ctx, req, *httpResp.JSON200, func(tpa api.ThirdPartyAuth, result *list.ListResult) {
+		data := ThirdPartyAuthResourceModel{
+			ProjectRef: config.ProjectRef,
+			Id:         types.StringValue(tpa.Id.String()),
+		}
+		result.DisplayName = thirdPartyAuthDisplayName(tpa)
+		result.Diagnostics.Append(result.Identity.Set(ctx, thirdPartyAuthIdentity(&data))...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		// The list response already carries every attribute, no need to read each integration
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		data.ProjectRef = config.ProjectRef
+		if diags := setThirdPartyAuthState(&data, tpa); diags.HasError() {
+			result.Diagnostics.Append(diags...)
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
+
+// thirdPartyAuthDisplayName names an integration by its issuer or JWKS URL when available.
+func thirdPartyAuthDisplayName(tpa api.ThirdPartyAuth) string {
+	if url, err := tpa.OidcIssuerUrl.Get(); err == nil && url != "" {
+		return url
+	}
+	if url, err := tpa.JwksUrl.Get(); err == nil && url != "" {
+		return url
+	}
+	return tpa.Id.String()
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccThirdPartyAuthListResource(t *testing.T) {
+	defer gock.OffAll()
+
+	gock.New(defaultApiEndpoint).
+		Get(thirdPartyAuthApiPath + "$").
+		Persist().
+		Reply(http.StatusOK).
+		JSON([]api.ThirdPartyAuth{oidcThirdPartyAuthResponse(t, "https://issuer.example.com")})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Query: true,
+				Config: testAccQueryProviderConfig + examples.ThirdPartyAuthListResourceConfig + `
+list "supabase_third_party_auth" "full" {
+  provider         = supabase
+  include_resource = true
+
+  config {
+    project_ref = "` + testProjectRef + `"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("supabase_third_party_auth.all", 1),
+					querycheck.ExpectIdentity("supabase_third_party_auth.all", map[string]knownvalue.Check{
+						"project_ref": knownvalue.StringExact(testProjectRef),
+						"id":          knownvalue.StringExact(testThirdPartyAuthUUID),
+					}),
+					querycheck.ExpectResourceKnownValues("supabase_third_party_auth.full", queryfilter.ByDisplayName(knownvalue.StringExact("https://issuer.example.com")), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("type"), KnownValue: knownvalue.StringExact("oidc")},
+						{Path: tfjsonpath.New("oidc_issuer_url"), KnownValue: knownvalue.StringExact("https://issuer.example.com")},
+					}),
+				},
+			},
+		},
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -33,6 +34,7 @@ var (
 	_ resource.Resource                     = &ThirdPartyAuthResource{}
 	_ resource.ResourceWithConfigValidators = &ThirdPartyAuthResource{}
 	_ resource.ResourceWithImportState      = &ThirdPartyAuthResource{}
+	_ resource.ResourceWithIdentity         = &ThirdPartyAuthResource{}
 )
 
 func NewThirdPartyAuthResource() resource.Resource {
@@ -40,7 +42,7 @@ func NewThirdPartyAuthResource() resource.Resource {
 }
 
 type ThirdPartyAuthResource struct {
-	client *api.ClientWithResponses
+	client Client
 }
 
 type ThirdPartyAuthResourceModel struct {
@@ -57,6 +59,18 @@ type ThirdPartyAuthResourceModel struct {
 	Timeouts      timeouts.Value       `tfsdk:"timeouts"`
 }
 
+type ThirdPartyAuthResourceIdentityModel struct {
+	ProjectRef types.String `tfsdk:"project_ref"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func thirdPartyAuthIdentity(data *ThirdPartyAuthResourceModel) ThirdPartyAuthResourceIdentityModel {
+	return ThirdPartyAuthResourceIdentityModel{
+		ProjectRef: data.ProjectRef,
+		Id:         data.Id,
+	}
+}
+
 type publicJWKSValidator struct{}
 
 // RequiresReplace uses raw string equality, not jsontypes.Normalized semantic
@@ -227,6 +241,21 @@ func (r *ThirdPartyAuthResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *ThirdPartyAuthResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_ref": identityschema.StringAttribute{
+				Description:       "Project reference ID",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "Third-party auth integration ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ThirdPartyAuthResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
 		r.client = client
@@ -265,6 +294,7 @@ func (r *ThirdPartyAuthResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created third party auth")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, thirdPartyAuthIdentity(&data))...)
 }
 
 func (r *ThirdPartyAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -289,6 +319,7 @@ func (r *ThirdPartyAuthResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Trace(ctx, "read third party auth")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, thirdPartyAuthIdentity(&data))...)
 }
 
 func (r *ThirdPartyAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -345,6 +376,7 @@ func (r *ThirdPartyAuthResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated third party auth local state")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, thirdPartyAuthIdentity(&state))...)
 }
 
 func (r *ThirdPartyAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -364,18 +396,28 @@ func (r *ThirdPartyAuthResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ThirdPartyAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, "/", 2)
-	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format 'project_ref/tpa_id', got: %s", req.ID),
-		)
-		return
+	var identity ThirdPartyAuthResourceIdentityModel
+	if req.ID != "" {
+		parts := strings.SplitN(req.ID, "/", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected import ID in format 'project_ref/tpa_id', got: %s", req.ID),
+			)
+			return
+		}
+		identity.ProjectRef = types.StringValue(strings.TrimSpace(parts[0]))
+		identity.Id = types.StringValue(strings.TrimSpace(parts[1]))
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	data := ThirdPartyAuthResourceModel{
-		ProjectRef: types.StringValue(strings.TrimSpace(parts[0])),
-		Id:         types.StringValue(strings.TrimSpace(parts[1])),
+		ProjectRef: identity.ProjectRef,
+		Id:         identity.Id,
 		Timeouts: timeouts.Value{
 			Object: types.ObjectNull(map[string]attr.Type{
 				"create": types.StringType,
@@ -397,9 +439,10 @@ func (r *ThirdPartyAuthResource) ImportState(ctx context.Context, req resource.I
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, thirdPartyAuthIdentity(&data))...)
 }
 
-func createThirdPartyAuth(ctx context.Context, data *ThirdPartyAuthResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func createThirdPartyAuth(ctx context.Context, data *ThirdPartyAuthResourceModel, client Client) diag.Diagnostics {
 	body, diags := buildThirdPartyAuthCreateBody(data)
 	if diags.HasError() {
 		return diags
@@ -418,7 +461,7 @@ func createThirdPartyAuth(ctx context.Context, data *ThirdPartyAuthResourceModel
 	return setThirdPartyAuthState(data, *httpResp.JSON201)
 }
 
-func readThirdPartyAuth(ctx context.Context, data *ThirdPartyAuthResourceModel, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+func readThirdPartyAuth(ctx context.Context, data *ThirdPartyAuthResourceModel, client Client) (bool, diag.Diagnostics) {
 	tpaID, diags := parseThirdPartyAuthUUID(data.Id.ValueString())
 	if diags.HasError() {
 		return false, diags
@@ -443,7 +486,7 @@ func readThirdPartyAuth(ctx context.Context, data *ThirdPartyAuthResourceModel,
 	return true, nil
 }
 
-func deleteThirdPartyAuth(ctx context.Context, data *ThirdPartyAuthResourceModel, client *api.ClientWithResponses) diag.Diagnostics {
+func deleteThirdPartyAuth(ctx context.Context, data *ThirdPartyAuthResourceModel, client Client) diag.Diagnostics {
 	tpaID, diags := parseThirdPartyAuthUUID(data.Id.ValueString())
 	if diags.HasError() {
 		return diags
@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,6 +38,73 @@ func NullableToString[T ~string](n nullable.Nullable[T]) tftypes.String {
 
 const defaultWaitTimeout = 5 * time.Minute
 
+const (
+	projectHostDomain = "supabase.co"
+
+	defaultDatabasePort = 5432
+	defaultDatabaseName = "postgres"
+	defaultDatabaseUser = "postgres"
+	// Port serving transaction mode on both the dedicated and shared pooler.
+	poolerTransactionPort = 6543
+)
+
+// projectRefPattern matches the reference ID of a project or branch.
+var projectRefPattern = regexp.MustCompile(`^[a-z]{20}$`)
+
+// projectApiUrl returns the public API URL of a project.
+func projectApiUrl(projectRef string) string {
+	return fmt.Sprintf("https://%s.%s", projectRef, projectHostDomain)
+}
+
+// projectDbHost returns the direct database host of a project.
+func projectDbHost(projectRef string) string {
+	return fmt.Sprintf("db.%s.%s", projectRef, projectHostDomain)
+}
+
+// poolerUser returns the user name for connecting through the shared pooler,
+// which identifies the tenant by suffixing the role with the project ref.
+func poolerUser(role, projectRef string) string {
+	return fmt.Sprintf("%s.%s", role, projectRef)
+}
+
+// postgresUrl returns the connection string of a database endpoint.
+func postgresUrl(user *url.Userinfo, host string, port int, database string) string {
+	connection := url.URL{
+		Scheme: "postgresql",
+		User:   user,
+		Host:   net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:   database,
+	}
+	return connection.String()
+}
+
+// parseProjectRef extracts the project ref from a project API URL, database
+// host or a connection string.
+func parseProjectRef(rawUrl string) (string, error) {
+	if !strings.Contains(rawUrl, "://") {
+		rawUrl = "https://" + rawUrl
+	}
+	parsed, err := url.Parse(rawUrl)
+	if err != nil {
+		return "", err
+	}
+
+	host := parsed.Hostname()
+	if sub, ok := strings.CutSuffix(host, "."+projectHostDomain); ok {
+		if ref := strings.TrimPrefix(sub, "db."); ref != "" && !strings.Contains(ref, ".") {
+			return ref, nil
+		}
+	}
+	// Shared pooler connection strings carry the ref in the user name
+	if parsed.User != nil {
+		if _, ref, ok := strings.Cut(parsed.User.Username(), "."); ok && projectRefPattern.MatchString(ref) {
+			return ref, nil
+		}
+	}
+
+	return "", fmt.Errorf("no project ref found in %q", rawUrl)
+}
+
 var terminalProjectStatuses = []api.V1ProjectWithDatabaseResponseStatus{
 	api.V1ProjectWithDatabaseResponseStatusGOINGDOWN,
 	api.V1ProjectWithDatabaseResponseStatusINITFAILED,
@@ -45,7 +116,7 @@ var terminalProjectStatuses = []api.V1ProjectWithDatabaseResponseStatus{
 
 // fails fast on terminal states (GOING_DOWN, INIT_FAILED, REMOVED, etc.) and
 // keeps polling on transient states (COMING_UP, RESTORING, ACTIVE_UNHEALTHY, etc.).
-func waitForProjectActive(ctx context.Context, projectRef string, client *api.ClientWithResponses, timeout time.Duration) diag.Diagnostics {
+func waitForProjectActive(ctx context.Context, projectRef string, client Client, timeout time.Duration) diag.Diagnostics {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			string(api.V1ProjectWithDatabaseResponseStatusACTIVEUNHEALTHY),
@@ -99,10 +170,58 @@ func waitForProjectActive(ctx context.Context, projectRef string, client *api.Cl
 	return nil
 }
 
+// waitForProjectPaused polls until a project reaches the INACTIVE state after
+// being paused, failing fast when pausing fails.
+func waitForProjectPaused(ctx context.Context, projectRef string, client Client, timeout time.Duration) diag.Diagnostics {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(api.V1ProjectWithDatabaseResponseStatusACTIVEHEALTHY),
+			string(api.V1ProjectWithDatabaseResponseStatusACTIVEUNHEALTHY),
+			string(api.V1ProjectWithDatabaseResponseStatusGOINGDOWN),
+			string(api.V1ProjectWithDatabaseResponseStatusPAUSING),
+			string(api.V1ProjectWithDatabaseResponseStatusUNKNOWN),
+		},
+		Target: []string{
+			string(api.V1ProjectWithDatabaseResponseStatusINACTIVE),
+		},
+		Refresh: func() (any, string, error) {
+			httpResp, err := client.V1GetProjectWithResponse(ctx, projectRef)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get project status: %w", err)
+			}
+			if httpResp.JSON200 == nil {
+				return nil, "", fmt.Errorf("unexpected status %d: %s", httpResp.StatusCode(), httpResp.Body)
+			}
+
+			status := string(httpResp.JSON200.Status)
+			tflog.Debug(ctx, "Waiting for project to pause", map[string]interface{}{
+				"project_ref": projectRef,
+				"status":      status,
+			})
+
+			if httpResp.JSON200.Status == api.V1ProjectWithDatabaseResponseStatusPAUSEFAILED {
+				return nil, "", fmt.Errorf("project %s in terminal state: %s", projectRef, status)
+			}
+
+			return httpResp.JSON200, status, nil
+		},
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Project Not Paused",
+			fmt.Sprintf("Project %s did not pause within timeout: %s", projectRef, err),
+		)}
+	}
+	return nil
+}
+
 // refreshBranchStatus polls branch status for refs that 404 on the projects
 // endpoint. BranchDetailResponseStatus shares its values with the project
 // status enum, so the result feeds the same state machine.
-func refreshBranchStatus(ctx context.Context, projectRef string, client *api.ClientWithResponses, projectResp *api.V1GetProjectResponse) (any, string, error) {
+func refreshBranchStatus(ctx context.Context, projectRef string, client Client, projectResp *api.V1GetProjectResponse) (any, string, error) {
 	httpResp, err := client.V1GetABranchConfigWithResponse(ctx, projectRef)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to get branch status: %w", err)
@@ -142,7 +261,7 @@ var allProjectServices = []api.V1GetServicesHealthParamsServices{
 // isDataApiDisabled checks whether the Data API (PostgREST) is currently disabled
 // by fetching the project's PostgREST config and checking if db_schema is empty.
 // This is the canonical mechanism used by the Supabase Dashboard to disable the Data API.
-func isDataApiDisabled(ctx context.Context, projectRef string, client *api.ClientWithResponses) (bool, diag.Diagnostics) {
+func isDataApiDisabled(ctx context.Context, projectRef string, client Client) (bool, diag.Diagnostics) {
 	httpResp, err := client.V1GetPostgrestServiceConfigWithResponse(ctx, projectRef)
 	if err != nil {
 		msg := fmt.Sprintf("Unable to fetch PostgREST config for project %s, got error: %s", projectRef, err)
@@ -155,7 +274,7 @@ func isDataApiDisabled(ctx context.Context, projectRef string, client *api.Clien
 	return strings.TrimSpace(httpResp.JSON200.DbSchema) == "", nil
 }
 
-func waitForServicesActive(ctx context.Context, projectRef string, client *api.ClientWithResponses, timeout time.Duration) diag.Diagnostics {
+func waitForServicesActive(ctx context.Context, projectRef string, client Client, timeout time.Duration) diag.Diagnostics {
 	getServices := func() []api.V1GetServicesHealthParamsServices {
 		services := allProjectServices
 		disabled, diags := isDataApiDisabled(ctx, projectRef, client)
@@ -172,7 +291,7 @@ func waitForServicesActive(ctx context.Context, projectRef string, client *api.C
 	return waitForProjectServicesActive(ctx, projectRef, client, timeout, getServices, "services", "Project Services Unhealthy", true)
 }
 
-func waitForAuthServiceActive(ctx context.Context, projectRef string, client *api.ClientWithResponses, timeout time.Duration) diag.Diagnostics {
+func waitForAuthServiceActive(ctx context.Context, projectRef string, client Client, timeout time.Duration) diag.Diagnostics {
 	getServices := func() []api.V1GetServicesHealthParamsServices {
 		return []api.V1GetServicesHealthParamsServices{api.V1GetServicesHealthParamsServicesAuth}
 	}
@@ -183,7 +302,7 @@ func waitForAuthServiceActive(ctx context.Context, projectRef string, client *ap
 func waitForProjectServicesActive(
 	ctx context.Context,
 	projectRef string,
-	client *api.ClientWithResponses,
+	client Client,
 	timeout time.Duration,
 	getServices func() []api.V1GetServicesHealthParamsServices,
 	serviceLabel string,
@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/supabase/cli/pkg/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &VanitySubdomainResource{}
+	_ resource.ResourceWithImportState = &VanitySubdomainResource{}
+	_ resource.ResourceWithModifyPlan  = &VanitySubdomainResource{}
+	_ resource.ResourceWithIdentity    = &VanitySubdomainResource{}
+)
+
+func NewVanitySubdomainResource() resource.Resource {
+	return &VanitySubdomainResource{}
+}
+
+// VanitySubdomainResource defines the resource implementation.
+type VanitySubdomainResource struct {
+	client Client
+}
+
+// VanitySubdomainResourceModel describes the resource data model.
+type VanitySubdomainResourceModel struct {
+	ProjectRef      types.String `tfsdk:"project_ref"`
+	VanitySubdomain types.String `tfsdk:"vanity_subdomain"`
+	CustomDomain    types.String `tfsdk:"custom_domain"`
+	ApiUrl          types.String `tfsdk:"api_url"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *VanitySubdomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vanity_subdomain"
+}
+
+func (r *VanitySubdomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Vanity subdomain resource. Activates a branded `supabase.co` subdomain for a project's API.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_ref": schema.StringAttribute{
+				MarkdownDescription: "Project reference ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vanity_subdomain": schema.StringAttribute{
+				MarkdownDescription: "Subdomain to activate, availability is checked at plan time",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_domain": schema.StringAttribute{
+				MarkdownDescription: "Hostname serving the project's API",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "API URL of the project on the vanity subdomain",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Project identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VanitySubdomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only check subdomains that are about to be activated
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan VanitySubdomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ProjectRef.IsUnknown() || plan.VanitySubdomain.IsUnknown() {
+		return
+	}
+
+	httpResp, err := r.client.V1CheckVanitySubdomainAvailabilityWithResponse(ctx, plan.ProjectRef.ValueString(), api.V1CheckVanitySubdomainAvailabilityJSONRequestBody{
+		VanitySubdomain: plan.VanitySubdomain.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check vanity subdomain availability, got error: %s", err))
+		return
+	}
+	if httpResp.JSON201 == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check vanity subdomain availability, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+	if !httpResp.JSON201.Available {
+		resp.Diagnostics.AddAttributeError(path.Root("vanity_subdomain"), "Vanity Subdomain Unavailable",
+			fmt.Sprintf("Subdomain %q is already taken or not allowed", plan.VanitySubdomain.ValueString()))
+	}
+}
+
+func (r *VanitySubdomainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectRefIdentitySchema()
+}
+
+func (r *VanitySubdomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := extractClient(req.ProviderData, &resp.Diagnostics); ok {
+		r.client = client
+	}
+}
+
+func (r *VanitySubdomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VanitySubdomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(activateVanitySubdomain(ctx, &data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "activated vanity subdomain")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *VanitySubdomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VanitySubdomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readVanitySubdomain(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read vanity subdomain")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *VanitySubdomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VanitySubdomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every configurable attribute requires replacement, so there is nothing to update.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func (r *VanitySubdomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VanitySubdomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.V1DeactivateVanitySubdomainConfigWithResponse(ctx, data.ProjectRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate vanity subdomain, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("vanity subdomain not found: %s", data.ProjectRef.ValueString()))
+		return
+	}
+	if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate vanity subdomain, got status %d: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	tflog.Trace(ctx, "deactivated vanity subdomain")
+}
+
+func (r *VanitySubdomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectRef, diags := importProjectRef(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := VanitySubdomainResourceModel{
+		ProjectRef:      types.StringValue(projectRef),
+		VanitySubdomain: types.StringNull(),
+	}
+
+	found, diags := readVanitySubdomain(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("Project %s has no active vanity subdomain", projectRef),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, projectRefIdentity(data.ProjectRef))...)
+}
+
+func setVanitySubdomainAttributes(data *VanitySubdomainResourceModel, customDomain string) {
+	data.Id = data.ProjectRef
+	data.CustomDomain = types.StringValue(customDomain)
+	data.ApiUrl = types.StringValue("https://" + customDomain)
+	// Always refresh from the API so out of band changes show up as drift
+	subdomain, _, _ := strings.Cut(customDomain, ".")
+	data.VanitySubdomain = types.StringValue(subdomain)
+}
+
+func readVanitySubdomain(ctx context.Context, data *VanitySubdomainResourceModel, client Client) (bool, diag.Diagnostics) {
+	httpResp, err := client.V1GetVanitySubdomainConfigWithResponse(ctx, data.ProjectRef.ValueString())
+	if err != nil {
+		msg := fmt.Sprintf("Unable to read vanity subdomain, got error: %s", err)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.StatusCode() == http.StatusNotFound {
+		tflog.Trace(ctx, fmt.Sprintf("project not found: %s", data.ProjectRef.ValueString()))
+		return false, nil
+	}
+	if httpResp.JSON200 == nil {
+		msg := fmt.Sprintf("Unable to read vanity subdomain, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	// A deactivated or replaced subdomain has to be activated again
+	if httpResp.JSON200.Status != api.Active || httpResp.JSON200.CustomDomain == nil {
+		tflog.Trace(ctx, fmt.Sprintf("vanity subdomain not active: %s", httpResp.JSON200.Status))
+		return false, nil
+	}
+
+	setVanitySubdomainAttributes(data, *httpResp.JSON200.CustomDomain)
+	return true, nil
+}
+
+func activateVanitySubdomain(ctx context.Context, data *VanitySubdomainResourceModel, client Client) diag.Diagnostics {
+	httpResp, err := client.V1ActivateVanitySubdomainConfigWithResponse(ctx, data.ProjectRef.ValueString(), api.V1ActivateVanitySubdomainConfigJSONRequestBody{
+		VanitySubdomain: data.VanitySubdomain.ValueString(),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Unable to activate vanity subdomain, got error: %s", err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+	if httpResp.JSON201 == nil {
+		msg := fmt.Sprintf("Unable to activate vanity subdomain, got status %d: %s", httpResp.StatusCode(), httpResp.Body)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", msg)}
+	}
+
+	setVanitySubdomainAttributes(data, httpResp.JSON201.CustomDomain)
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/supabase/cli/pkg/api"
+	"github.com/supabase/terraform-provider-supabase/examples"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestAccVanitySubdomainResource(t *testing.T) {
+	defer gock.OffAll()
+	// Step 1: create
+	gock.New(defaultApiEndpoint).
+		Post(vanitySubdomainApiPath + "/check-availability").
+		AddMatcher(matchJSONBodyField("vanity_subdomain", "acme")).
+		Times(2).
+		Reply(http.StatusCreated).
+		JSON(api.SubdomainAvailabilityResponse{Available: true})
+	gock.New(defaultApiEndpoint).
+		Post(vanitySubdomainApiPath + "/activate").
+		AddMatcher(matchJSONBodyField("vanity_subdomain", "acme")).
+		Reply(http.StatusCreated).
+		JSON(api.ActivateVanitySubdomainResponse{CustomDomain: "acme.supabase.co"})
+	gock.New(defaultApiEndpoint).
+		Get(vanitySubdomainApiPath).
+		Times(3).
+		Reply(http.StatusOK).
+		JSON(api.VanitySubdomainConfigResponse{
+			Status:       api.Active,
+			CustomDomain: Ptr("acme.supabase.co"),
+		})
+	// Delete
+	gock.New(defaultApiEndpoint).
+		Delete(vanitySubdomainApiPath).
+		Reply(http.StatusOK)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: examples.VanitySubdomainResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("supabase_vanity_subdomain.production", "id", testProjectRef),
+					resource.TestCheckResourceAttr("supabase_vanity_subdomain.production", "custom_domain", "acme.supabase.co"),
+					resource.TestCheckResourceAttr("supabase_vanity_subdomain.production", "api_url", "https://acme.supabase.co"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "supabase_vanity_subdomain.production",
+				ImportState:       true,
+				ImportStateId:     testProjectRef,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccVanitySubdomainResource_Unavailable(t *testing.T) {
+	defer gock.OffAll()
+	gock.New(defaultApiEndpoint).
+		Post(vanitySubdomainApiPath + "/check-availability").
+		Reply(http.StatusCreated).
+		JSON(api.SubdomainAvailabilityResponse{Available: false})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      examples.VanitySubdomainResourceConfig,
+				ExpectError: regexp.MustCompile(`Vanity Subdomain Unavailable`),
+			},
+		},
+	})
+}
+
+func TestReadVanitySubdomain_RefreshesFromApi(t *testing.T) {
+	defer gock.OffAll()
+	gock.InterceptClient(http.DefaultClient)
+	defer gock.RestoreClient(http.DefaultClient)
+
+	// Subdomain was changed outside of Terraform
+	gock.New(defaultApiEndpoint).
+		Get(vanitySubdomainApiPath).
+		Reply(http.StatusOK).
+		JSON(api.VanitySubdomainConfigResponse{
+			Status:       api.Active,
+			CustomDomain: Ptr("widgets.supabase.co"),
+		})
+	// Subdomain was deactivated outside of Terraform
+	gock.New(defaultApiEndpoint).
+		Get(vanitySubdomainApiPath).
+		Reply(http.StatusOK).
+		JSON(api.VanitySubdomainConfigResponse{
+			Status: api.NotUsed,
+		})
+
+	client, err := newClient(defaultApiEndpoint)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	data := VanitySubdomainResourceModel{
+		ProjectRef:      types.StringValue(testProjectRef),
+		VanitySubdomain: types.StringValue("acme"),
+	}
+	found, diags := readVanitySubdomain(t.Context(), &data, client)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if !found {
+		t.Fatal("Expected active vanity subdomain to be found")
+	}
+	if data.VanitySubdomain.ValueString() != "widgets" {
+		t.Errorf("Expected refreshed subdomain widgets, got %s", data.VanitySubdomain)
+	}
+	if data.ApiUrl.ValueString() != "https://widgets.supabase.co" {
+		t.Errorf("Expected refreshed api url, got %s", data.ApiUrl)
+	}
+
+	found, diags = readVanitySubdomain(t.Context(), &data, client)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if found {
+		t.Error("Expected deactivated vanity subdomain to be removed from state")
+	}
+}
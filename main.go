@@ -6,7 +6,9 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/supabase/terraform-provider-supabase/internal/provider"
@@ -32,6 +34,11 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		generate(os.Args[2:])
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -48,3 +55,29 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// generate writes Terraform configuration and import blocks for an existing project.
+func generate(args []string) {
+	var projectRef, outputDir string
+
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	flags.StringVar(&projectRef, "project", "", "reference ID of the project to generate configuration for")
+	flags.StringVar(&outputDir, "out", ".", "directory to write the generated configuration and function sources to")
+	_ = flags.Parse(args)
+
+	if projectRef == "" {
+		log.Fatal("the -project flag is required")
+	}
+
+	diags := provider.Generate(context.Background(), provider.GenerateOptions{
+		ProjectRef: projectRef,
+		OutputDir:  outputDir,
+		Version:    version,
+	})
+	for _, d := range diags {
+		fmt.Fprintf(os.Stderr, "%s: %s: %s\n", d.Severity(), d.Summary(), d.Detail())
+	}
+	if diags.HasError() {
+		os.Exit(1)
+	}
+}